@@ -0,0 +1,179 @@
+package wappin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flip-id/wappin/internal/receiver"
+	"github.com/flip-id/wappin/verifier"
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+)
+
+// MaxCallbackBodySize is the maximum size of the callback body read by the callback handlers.
+const MaxCallbackBodySize = 1 << 20
+
+// CallbackType is the type of the callback from Wappin.
+type CallbackType string
+
+// List of callback types from Wappin.
+const (
+	CallbackTypeUnknown         CallbackType = "unknown"
+	CallbackTypeMessageStatus   CallbackType = "message_status"
+	CallbackTypeIncomingMessage CallbackType = "incoming_message"
+)
+
+// ParseCallbackType parses the callback type from Wappin to CallbackType, the unrecognized type is parsed as CallbackTypeUnknown.
+func ParseCallbackType(callbackType string) CallbackType {
+	switch t := CallbackType(strings.ToLower(strings.TrimSpace(callbackType))); t {
+	case CallbackTypeMessageStatus, CallbackTypeIncomingMessage:
+		return t
+	}
+
+	return CallbackTypeUnknown
+}
+
+// callbackLocation is the timezone of the Timestamp without offset in the callback.
+var callbackLocation = time.FixedZone("WIB", 7*60*60)
+
+// Callback is the parsed CallbackData with the typed fields.
+type Callback struct {
+	CallbackData
+	Time   time.Time
+	Status MessageStatus
+	Type   CallbackType
+}
+
+// Parse parses the CallbackData to Callback, the Timestamp can be RFC3339, "2006-01-02 15:04:05" in WIB or Unix seconds.
+func (d *CallbackData) Parse() (cb *Callback, err error) {
+	cb = &Callback{
+		CallbackData: *d,
+		Status:       ParseMessageStatus(d.StatusMessages),
+		Type:         ParseCallbackType(d.CallbackType),
+	}
+
+	cb.Time, err = parseCallbackTimestamp(d.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func parseCallbackTimestamp(timestamp string) (t time.Time, err error) {
+	timestamp = strings.TrimSpace(timestamp)
+	if timestamp == "" {
+		return
+	}
+
+	if unix, errParse := strconv.ParseInt(timestamp, 10, 64); errParse == nil {
+		t = time.Unix(unix, 0)
+		return
+	}
+
+	t, err = time.Parse(time.RFC3339, timestamp)
+	if err == nil {
+		return
+	}
+
+	t, err = time.ParseInLocation("2006-01-02 15:04:05", timestamp, callbackLocation)
+	if err != nil {
+		err = errors.Wrapf(ErrInvalidCallback, "invalid timestamp %q", timestamp)
+	}
+
+	return
+}
+
+// ParseCallback decodes the callback body from Wappin and parses it to Callback.
+func ParseCallback(b []byte) (cb *Callback, err error) {
+	var data CallbackData
+	err = json.Unmarshal(b, &data)
+	if err != nil {
+		err = errors.Wrap(ErrInvalidCallback, err.Error())
+		return
+	}
+
+	return data.Parse()
+}
+
+// CallbackFunc is the function invoked for every callback from Wappin.
+type CallbackFunc func(ctx context.Context, cb *Callback) error
+
+// NewCallbackHandler returns the net/http handler for the v1 callback from Wappin, only the POST request is accepted.
+// The body over MaxCallbackBodySize is rejected with 413 before it is verified by the opts,
+// and the body which cannot be parsed by ParseCallback is rejected with 400.
+// The callback is acknowledged with 200 only if the fn succeeds, so Wappin sends it again after the 500.
+func NewCallbackHandler(fn CallbackFunc, opts ...verifier.FnOption) http.Handler {
+	o := verifier.NewOption(opts...)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, statusCode, err := receiver.ReadBody(r.Body, MaxCallbackBodySize, ErrInvalidCallback)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		err = o.Verify(r.Context(), verifier.NewHTTPRequest(r, body))
+		if err != nil {
+			statusCode = verifier.StatusCode(err)
+			http.Error(w, http.StatusText(statusCode), statusCode)
+			return
+		}
+
+		cb, err := ParseCallback(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		statusCode, err = receiver.Invoke(func() error {
+			return fn(r.Context(), cb)
+		})
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.WriteHeader(statusCode)
+	})
+}
+
+// NewFiberCallbackHandler returns the Fiber handler responding the v1 callback the same way as NewCallbackHandler.
+// The body is read by Fiber first, so the BodyLimit of the Fiber app must not be lower than MaxCallbackBodySize.
+func NewFiberCallbackHandler(fn CallbackFunc, opts ...verifier.FnOption) fiber.Handler {
+	o := verifier.NewOption(opts...)
+	return func(c *fiber.Ctx) error {
+		body := c.Body()
+		statusCode, err := receiver.CheckBodySize(body, MaxCallbackBodySize, ErrInvalidCallback)
+		if err != nil {
+			return c.Status(statusCode).SendString(err.Error())
+		}
+
+		err = o.Verify(c.UserContext(), verifier.NewFiberRequest(c))
+		if err != nil {
+			return c.SendStatus(verifier.StatusCode(err))
+		}
+
+		cb, err := ParseCallback(body)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+
+		statusCode, err = receiver.Invoke(func() error {
+			return fn(c.UserContext(), cb)
+		})
+		if err != nil {
+			return c.Status(statusCode).SendString(err.Error())
+		}
+
+		return c.SendStatus(statusCode)
+	}
+}
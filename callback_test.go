@@ -0,0 +1,224 @@
+package wappin_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flip-id/wappin"
+	"github.com/flip-id/wappin/verifier"
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	callbackJson = `{"message_id":"message-id","client_id":"client-id","project_id":"project-id","status_messages":"Delivered","timestamp":"2023-08-03 10:45:36","sender_number":"6288889999","callback_type":"message_status"}`
+)
+
+func TestParseCallback(t *testing.T) {
+	tt := []struct {
+		name      string
+		body      string
+		expect    func() *wappin.Callback
+		expectErr bool
+	}{
+		{
+			name: "success parse callback",
+			body: callbackJson,
+			expect: func() *wappin.Callback {
+				return &wappin.Callback{
+					CallbackData: wappin.CallbackData{
+						MessageID:      "message-id",
+						ClientID:       "client-id",
+						ProjectID:      "project-id",
+						StatusMessages: "Delivered",
+						Timestamp:      "2023-08-03 10:45:36",
+						SenderNumber:   "6288889999",
+						CallbackType:   "message_status",
+					},
+					Time:   time.Date(2023, time.August, 3, 3, 45, 36, 0, time.UTC),
+					Status: wappin.MessageStatusDelivered,
+					Type:   wappin.CallbackTypeMessageStatus,
+				}
+			},
+			expectErr: false,
+		},
+		{
+			name: "success parse callback with unix timestamp and unknown type",
+			body: `{"message_id":"message-id","status_messages":"deleted","timestamp":"1691034336","callback_type":"other"}`,
+			expect: func() *wappin.Callback {
+				return &wappin.Callback{
+					CallbackData: wappin.CallbackData{
+						MessageID:      "message-id",
+						StatusMessages: "deleted",
+						Timestamp:      "1691034336",
+						CallbackType:   "other",
+					},
+					Time:   time.Unix(1691034336, 0),
+					Status: wappin.MessageStatusUnknown,
+					Type:   wappin.CallbackTypeUnknown,
+				}
+			},
+			expectErr: false,
+		},
+		{
+			name: "error invalid json",
+			body: `{"message_id":`,
+			expect: func() *wappin.Callback {
+				return nil
+			},
+			expectErr: true,
+		},
+		{
+			name: "error invalid timestamp",
+			body: `{"message_id":"message-id","timestamp":"yesterday"}`,
+			expect: func() *wappin.Callback {
+				return nil
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cb, err := wappin.ParseCallback([]byte(tc.body))
+			if tc.expectErr {
+				assert.True(t, errors.Is(err, wappin.ErrInvalidCallback))
+				assert.Nil(t, cb)
+				return
+			}
+
+			assert.Nil(t, err)
+			expect := tc.expect()
+			assert.True(t, expect.Time.Equal(cb.Time))
+			cb.Time = expect.Time
+			assert.Equal(t, expect, cb)
+		})
+	}
+}
+
+func TestCallbackHandlers(t *testing.T) {
+	tt := []struct {
+		name         string
+		body         string
+		fn           wappin.CallbackFunc
+		expectStatus int
+	}{
+		{
+			name: "success handle callback",
+			body: callbackJson,
+			fn: func(ctx context.Context, cb *wappin.Callback) error {
+				if cb.MessageID != "message-id" || cb.Status != wappin.MessageStatusDelivered {
+					return errors.New("unexpected callback")
+				}
+
+				return nil
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "error decode callback",
+			body: `not json`,
+			fn: func(ctx context.Context, cb *wappin.Callback) error {
+				return errors.New("must not be called")
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "error from callback func",
+			body: callbackJson,
+			fn: func(ctx context.Context, cb *wappin.Callback) error {
+				return errors.New("database is down")
+			},
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "error body too large",
+			body: `{"padding":"` + strings.Repeat("a", wappin.MaxCallbackBodySize) + `"}`,
+			fn: func(ctx context.Context, cb *wappin.Callback) error {
+				return errors.New("must not be called")
+			},
+			expectStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run("net/http "+tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(tc.body))
+
+			wappin.NewCallbackHandler(tc.fn).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectStatus, rec.Code)
+			assert.NotContains(t, rec.Body.String(), "database is down")
+		})
+
+		t.Run("fiber "+tc.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Post("/callback", wappin.NewFiberCallbackHandler(tc.fn))
+			req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(tc.body))
+
+			resp, err := app.Test(req)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectStatus, resp.StatusCode)
+			body, _ := io.ReadAll(resp.Body)
+			assert.NotContains(t, string(body), "database is down")
+		})
+	}
+}
+
+func TestCallbackHandlersVerification(t *testing.T) {
+	fn := func(ctx context.Context, cb *wappin.Callback) error {
+		return nil
+	}
+	opts := []verifier.FnOption{
+		verifier.WithVerifiers(verifier.NewTokenVerifier("", "token")),
+	}
+
+	tt := []struct {
+		name         string
+		token        string
+		expectStatus int
+	}{
+		{
+			name:         "accept callback with valid token",
+			token:        "token",
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "reject callback with invalid token",
+			token:        "invalid",
+			expectStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run("net/http "+tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(callbackJson))
+			req.Header.Set(verifier.DefaultTokenHeader, tc.token)
+
+			wappin.NewCallbackHandler(fn, opts...).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectStatus, rec.Code)
+		})
+
+		t.Run("fiber "+tc.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Post("/callback", wappin.NewFiberCallbackHandler(fn, opts...))
+			req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(callbackJson))
+			req.Header.Set(verifier.DefaultTokenHeader, tc.token)
+
+			resp, err := app.Test(req)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectStatus, resp.StatusCode)
+		})
+	}
+}
@@ -5,13 +5,19 @@ import (
 	"github.com/fairyhunter13/reflecthelper/v5"
 	"github.com/pkg/errors"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 // List of errors used in this package.
 var (
-	ErrNilArguments = errors.New("nil arguments")
+	ErrNilArguments    = errors.New("nil arguments")
+	ErrInvalidCallback = errors.New("invalid callback from Wappin")
 )
 
+// maxErrorBodyLength is the maximum length of the response body put in the message of the status error.
+const maxErrorBodyLength = 512
+
 // Error represents the error for Wappin.
 type Error struct {
 	Status  string `json:"status"`
@@ -31,6 +37,16 @@ func CastError(status string, message string) *Error {
 	}
 }
 
+// newStatusError creates the error from the status code and the truncated body if Wappin does not respond with JSON, e.g. the HTML of a proxy.
+func newStatusError(statusCode int, body []byte) *Error {
+	message := strings.TrimSpace(string(body))
+	if len(message) > maxErrorBodyLength {
+		message = message[:maxErrorBodyLength] + "..."
+	}
+
+	return CastError(strconv.Itoa(statusCode), message)
+}
+
 func getError(statusCode int, status string, message string) (err error) {
 	if !(reflecthelper.GetInt(status) >= http.StatusBadRequest ||
 		statusCode >= http.StatusBadRequest) {
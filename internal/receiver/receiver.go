@@ -0,0 +1,46 @@
+// Package receiver reads the requests sent by Wappin to the callback and webhook handlers and maps the results to the response status.
+package receiver
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ReadBody reads the body up to maxSize bytes, the statusCode is responded if the body cannot be read.
+// It is 413 if the body is larger than maxSize and 400 otherwise, the err wraps the errInvalid.
+func ReadBody(r io.Reader, maxSize int, errInvalid error) (b []byte, statusCode int, err error) {
+	b, err = io.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+	if err != nil {
+		statusCode = http.StatusBadRequest
+		err = errors.Wrap(errInvalid, err.Error())
+		return
+	}
+
+	statusCode, err = CheckBodySize(b, maxSize, errInvalid)
+	return
+}
+
+// CheckBodySize returns 413 with the err wrapping the errInvalid if the body already read is larger than maxSize.
+func CheckBodySize(b []byte, maxSize int, errInvalid error) (statusCode int, err error) {
+	if len(b) > maxSize {
+		statusCode = http.StatusRequestEntityTooLarge
+		err = errors.Wrapf(errInvalid, "body exceeds %d bytes", maxSize)
+	}
+
+	return
+}
+
+// Invoke invokes the fn and returns 500 if it fails and 200 otherwise, the err is the message responded with the statusCode.
+// The error of the fn is internal, e.g. the database error, so only the status text is responded to Wappin.
+func Invoke(fn func() error) (statusCode int, err error) {
+	if errInvoke := fn(); errInvoke != nil {
+		statusCode = http.StatusInternalServerError
+		err = errors.New(http.StatusText(statusCode))
+		return
+	}
+
+	statusCode = http.StatusOK
+	return
+}
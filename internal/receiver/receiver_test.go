@@ -0,0 +1,62 @@
+package receiver_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/flip-id/wappin/internal/receiver"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var errInvalid = errors.New("invalid body")
+
+func TestReadBody(t *testing.T) {
+	tt := []struct {
+		name         string
+		body         string
+		expectStatus int
+		expectErr    bool
+	}{
+		{
+			name: "Read body within the limit",
+			body: strings.Repeat("a", 8),
+		},
+		{
+			name:         "Reject body over the limit",
+			body:         strings.Repeat("a", 9),
+			expectStatus: http.StatusRequestEntityTooLarge,
+			expectErr:    true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			b, statusCode, err := receiver.ReadBody(strings.NewReader(tc.body), 8, errInvalid)
+
+			assert.Equal(t, tc.expectStatus, statusCode)
+			if tc.expectErr {
+				assert.True(t, errors.Is(err, errInvalid))
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.body, string(b))
+		})
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	statusCode, err := receiver.Invoke(func() error {
+		return nil
+	})
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Nil(t, err)
+
+	statusCode, err = receiver.Invoke(func() error {
+		return errors.New("database is down")
+	})
+	assert.Equal(t, http.StatusInternalServerError, statusCode)
+	assert.Equal(t, http.StatusText(http.StatusInternalServerError), err.Error())
+}
@@ -0,0 +1,35 @@
+package wappin
+
+import "strings"
+
+// MessageStatus is the delivery status of the message sent by Wappin.
+type MessageStatus string
+
+// List of message statuses from Wappin.
+const (
+	MessageStatusUnknown   MessageStatus = "unknown"
+	MessageStatusPending   MessageStatus = "pending"
+	MessageStatusSent      MessageStatus = "sent"
+	MessageStatusDelivered MessageStatus = "delivered"
+	MessageStatusRead      MessageStatus = "read"
+	MessageStatusFailed    MessageStatus = "failed"
+)
+
+// ParseMessageStatus parses the status from Wappin to MessageStatus, the unrecognized status is parsed as MessageStatusUnknown.
+func ParseMessageStatus(status string) MessageStatus {
+	switch s := MessageStatus(strings.ToLower(strings.TrimSpace(status))); s {
+	case MessageStatusPending,
+		MessageStatusSent,
+		MessageStatusDelivered,
+		MessageStatusRead,
+		MessageStatusFailed:
+		return s
+	}
+
+	return MessageStatusUnknown
+}
+
+// IsFinal returns true if the status will not change anymore.
+func (s MessageStatus) IsFinal() bool {
+	return s == MessageStatusRead || s == MessageStatusFailed
+}
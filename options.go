@@ -25,8 +25,9 @@ const (
 
 // List of all endpoints used in this package.
 const (
-	EndpointSendHSM = "/v1/message/do-send-hsm"
-	EndpointToken   = "/v1/token/get"
+	EndpointSendHSM       = "/v1/message/do-send-hsm"
+	EndpointMessageStatus = "/v1/message/status"
+	EndpointToken         = "/v1/token/get"
 )
 
 // Option is option for initializing Wappin client.
@@ -44,6 +44,44 @@ func (r *RequestWhatsappMessage) Default(o *Option) *RequestWhatsappMessage {
 	return r
 }
 
+// RequestMessageStatus is a request for getting the delivery status of the message.
+type RequestMessageStatus struct {
+	ClientID  string `json:"client_id"`
+	ProjectID string `json:"project_id"`
+	MessageID string `json:"message_id"`
+}
+
+// Default returns the default request for get message status in Wappin.
+func (r *RequestMessageStatus) Default(o *Option) *RequestMessageStatus {
+	if r.ClientID == "" {
+		r.ClientID = o.ClientID
+	}
+
+	if r.ProjectID == "" {
+		r.ProjectID = o.ProjectID
+	}
+
+	return r
+}
+
+// ResponseMessageStatus is a response of the delivery status of the message from the Wappin.
+type ResponseMessageStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		MessageID      string `json:"message_id"`
+		StatusMessages string `json:"status_messages"`
+		Timestamp      string `json:"timestamp"`
+	} `json:"data"`
+	HttpStatusCode int    `json:"-"`
+	RawData        string `json:"-"`
+}
+
+// MessageStatus returns the typed delivery status of the message.
+func (r *ResponseMessageStatus) MessageStatus() MessageStatus {
+	return ParseMessageStatus(r.Data.StatusMessages)
+}
+
 // CallbackData is a callback data from Wappin.
 type CallbackData struct {
 	MessageID      string `json:"message_id"`
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+)
+
+// memorySweepInterval is the number of saves before the expired objects are removed from the memory.
+const memorySweepInterval = 1000
+
+type memoryItem struct {
+	value     []byte
+	expiredAt time.Time
+}
+
+type memoryStorage struct {
+	mu    sync.Mutex
+	items map[string]memoryItem
+	saves int
+}
+
+// NewMemory creates a new in-memory storage, it is only shared within the process.
+// The object is encoded as JSON like the Redis storage, and redis.Nil is returned if the key does not exist.
+func NewMemory() IDedupeStorage {
+	return &memoryStorage{
+		items: make(map[string]memoryItem),
+	}
+}
+
+// Get returns the object from the memory.
+func (m *memoryStorage) Get(ctx context.Context, key string) (i interface{}, err error) {
+	m.mu.Lock()
+	item, ok := m.items[key]
+	if ok && m.isExpired(item) {
+		delete(m.items, key)
+		ok = false
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		err = redis.Nil
+		return
+	}
+
+	err = json.Unmarshal(item.value, &i)
+	return
+}
+
+// Save saves the object to the memory, the object never expires if the ttl is zero.
+func (m *memoryStorage) Save(ctx context.Context, key string, i interface{}, ttl time.Duration) (err error) {
+	item, err := newMemoryItem(i, ttl)
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.set(key, item)
+	return
+}
+
+// SaveIfAbsent saves the object to the memory only if the key does not exist or is expired.
+func (m *memoryStorage) SaveIfAbsent(ctx context.Context, key string, i interface{}, ttl time.Duration) (ok bool, err error) {
+	item, err := newMemoryItem(i, ttl)
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, exists := m.items[key]; exists && !m.isExpired(existing) {
+		return
+	}
+
+	m.set(key, item)
+	ok = true
+	return
+}
+
+// Delete deletes the object from the memory.
+func (m *memoryStorage) Delete(ctx context.Context, key string) (err error) {
+	m.mu.Lock()
+	delete(m.items, key)
+	m.mu.Unlock()
+	return
+}
+
+func newMemoryItem(i interface{}, ttl time.Duration) (item memoryItem, err error) {
+	if i == nil {
+		err = errors.New("object cannot be null")
+		return
+	}
+
+	item.value, err = json.Marshal(i)
+	if err != nil {
+		return
+	}
+
+	if ttl > 0 {
+		item.expiredAt = time.Now().Add(ttl)
+	}
+
+	return
+}
+
+func (m *memoryStorage) set(key string, item memoryItem) {
+	m.items[key] = item
+	m.saves++
+	if m.saves >= memorySweepInterval {
+		m.sweep()
+	}
+}
+
+func (m *memoryStorage) isExpired(item memoryItem) bool {
+	return !item.expiredAt.IsZero() && !time.Now().Before(item.expiredAt)
+}
+
+func (m *memoryStorage) sweep() {
+	m.saves = 0
+	for key, item := range m.items {
+		if m.isExpired(item) {
+			delete(m.items, key)
+		}
+	}
+}
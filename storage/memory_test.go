@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryGetSave(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory()
+
+	_, err := m.Get(ctx, "token")
+	assert.Equal(t, redis.Nil, err)
+
+	assert.NotNil(t, m.Save(ctx, "token", nil, 0))
+
+	assert.Nil(t, m.Save(ctx, "token", "secret", 0))
+	got, err := m.Get(ctx, "token")
+	assert.Nil(t, err)
+	assert.Equal(t, "secret", got)
+
+	assert.Nil(t, m.Save(ctx, "token", "secret", time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	_, err = m.Get(ctx, "token")
+	assert.Equal(t, redis.Nil, err)
+}
+
+func TestMemorySaveIfAbsent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Claim the absent key once", func(t *testing.T) {
+		m := NewMemory()
+
+		ok, err := m.SaveIfAbsent(ctx, "event", true, time.Minute)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+
+		ok, err = m.SaveIfAbsent(ctx, "event", true, time.Minute)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Claim the key again after it expires", func(t *testing.T) {
+		m := NewMemory()
+
+		ok, err := m.SaveIfAbsent(ctx, "event", true, time.Millisecond)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+
+		time.Sleep(5 * time.Millisecond)
+		ok, err = m.SaveIfAbsent(ctx, "event", true, time.Minute)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Claim the key again after it is deleted", func(t *testing.T) {
+		m := NewMemory()
+
+		ok, err := m.SaveIfAbsent(ctx, "event", true, time.Minute)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+
+		assert.Nil(t, m.Delete(ctx, "event"))
+		ok, err = m.SaveIfAbsent(ctx, "event", true, time.Minute)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Error null object", func(t *testing.T) {
+		ok, err := NewMemory().SaveIfAbsent(ctx, "event", nil, time.Minute)
+		assert.NotNil(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestMemorySweep(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory().(*memoryStorage)
+
+	ok, err := m.SaveIfAbsent(ctx, "expired", true, time.Millisecond)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Nil(t, m.Save(ctx, "kept", true, 0))
+	time.Sleep(5 * time.Millisecond)
+
+	for i := 2; i < memorySweepInterval; i++ {
+		assert.Nil(t, m.Save(ctx, "key:"+strconv.Itoa(i), true, time.Minute))
+	}
+
+	assert.NotContains(t, m.items, "expired")
+	assert.Contains(t, m.items, "kept")
+	assert.Equal(t, 0, m.saves)
+}
@@ -14,12 +14,20 @@ type IRedisStorage interface {
 	Save(ctx context.Context, key string, i interface{}, ttl time.Duration) (err error)
 }
 
+// IDedupeStorage is the storage which can claim the key atomically, e.g. for deduplicating the webhook events.
+// SaveIfAbsent saves the object only if the key does not exist, ok is false if the key exists.
+type IDedupeStorage interface {
+	IRedisStorage
+	SaveIfAbsent(ctx context.Context, key string, i interface{}, ttl time.Duration) (ok bool, err error)
+	Delete(ctx context.Context, key string) (err error)
+}
+
 type redisStorage struct {
 	*redis.Client
 }
 
 // NewGoRedisV8 creates a new redis client for storage
-func NewGoRedisV8(c *redis.Client) IRedisStorage {
+func NewGoRedisV8(c *redis.Client) IDedupeStorage {
 	if c == nil {
 		return nil
 	}
@@ -62,3 +70,25 @@ func (r *redisStorage) Save(ctx context.Context, key string, i interface{}, ttl
 	err = cmd.Err()
 	return
 }
+
+// SaveIfAbsent saves the object to the Redis storage only if the key does not exist.
+func (r *redisStorage) SaveIfAbsent(ctx context.Context, key string, i interface{}, ttl time.Duration) (ok bool, err error) {
+	if i == nil {
+		err = errors.New("object cannot be null")
+		return
+	}
+
+	byteSlice, err := json.Marshal(i)
+	if err != nil {
+		return
+	}
+
+	ok, err = r.Client.SetNX(ctx, key, string(byteSlice), ttl).Result()
+	return
+}
+
+// Delete deletes the object from the Redis storage.
+func (r *redisStorage) Delete(ctx context.Context, key string) (err error) {
+	err = r.Client.Del(ctx, key).Err()
+	return
+}
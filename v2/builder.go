@@ -0,0 +1,103 @@
+package v2
+
+// TemplateBuilder builds a RequestMessage with template format.
+// The empty Namespace will be filled by the Namespace of the client when the message is sent.
+type TemplateBuilder struct {
+	to       string
+	template TemplateRequest
+	header   *ComponentRequest
+	body     *ComponentRequest
+	buttons  []ComponentRequest
+}
+
+// NewTemplate initializes a new builder for the template with the name and the language code.
+func NewTemplate(name string, lang string) *TemplateBuilder {
+	return &TemplateBuilder{
+		template: TemplateRequest{
+			Name: name,
+			Language: LanguageRequest{
+				Policy: LanguagePolicyDeterministic,
+				Code:   lang,
+			},
+		},
+	}
+}
+
+// To sets the recipient phone number.
+func (b *TemplateBuilder) To(phone string) *TemplateBuilder {
+	b.to = phone
+	return b
+}
+
+// Namespace sets the namespace of the template.
+func (b *TemplateBuilder) Namespace(namespace string) *TemplateBuilder {
+	b.template.Namespace = namespace
+	return b
+}
+
+// Policy sets the language policy of the template.
+func (b *TemplateBuilder) Policy(policy string) *TemplateBuilder {
+	b.template.Language.Policy = policy
+	return b
+}
+
+// Header sets the parameter of the header component, header only accepts one parameter.
+func (b *TemplateBuilder) Header(param ComponentParameterRequest) *TemplateBuilder {
+	b.header = &ComponentRequest{
+		Type:       ComponentTypeHeader,
+		Parameters: []ComponentParameterRequest{param},
+	}
+	return b
+}
+
+// Body appends the parameters of the body component.
+func (b *TemplateBuilder) Body(params ...ComponentParameterRequest) *TemplateBuilder {
+	if b.body == nil {
+		b.body = &ComponentRequest{
+			Type: ComponentTypeBody,
+		}
+	}
+
+	b.body.Parameters = append(b.body.Parameters, params...)
+	return b
+}
+
+// URLButton adds the dynamic URL suffix of the URL button at the index.
+func (b *TemplateBuilder) URLButton(index int, suffix string) *TemplateBuilder {
+	return b.button(index, Url, NewTextParameter(suffix))
+}
+
+// QuickReply adds the payload of the quick reply button at the index.
+func (b *TemplateBuilder) QuickReply(index int, payload string) *TemplateBuilder {
+	return b.button(index, QuickReply, NewPayloadParameter(payload))
+}
+
+func (b *TemplateBuilder) button(index int, subType string, param ComponentParameterRequest) *TemplateBuilder {
+	b.buttons = append(b.buttons, ComponentRequest{
+		Type:       ComponentTypeButton,
+		SubType:    subType,
+		Parameters: []ComponentParameterRequest{param},
+		Index:      &index,
+	})
+	return b
+}
+
+// Build returns a new RequestMessage from the builder, the components are ordered as header, body and buttons.
+func (b *TemplateBuilder) Build() *RequestMessage {
+	template := b.template
+	template.Components = make([]ComponentRequest, 0, len(b.buttons)+2)
+	if b.header != nil {
+		template.Components = append(template.Components, *b.header)
+	}
+
+	if b.body != nil {
+		template.Components = append(template.Components, *b.body)
+	}
+
+	template.Components = append(template.Components, b.buttons...)
+	return &RequestMessage{
+		To:       b.to,
+		Type:     MessageTypeTemplate,
+		Template: &template,
+	}
+}
@@ -0,0 +1,103 @@
+package v2_test
+
+import (
+	"testing"
+
+	v2 "github.com/flip-id/wappin/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateBuilder(t *testing.T) {
+	first, second := 0, 1
+
+	tt := []struct {
+		name   string
+		build  func() *v2.RequestMessage
+		expect *v2.RequestMessage
+	}{
+		{
+			name: "Template without components",
+			build: func() *v2.RequestMessage {
+				return v2.NewTemplate("otp", "id").To("6288889999").Build()
+			},
+			expect: &v2.RequestMessage{
+				To:   "6288889999",
+				Type: v2.MessageTypeTemplate,
+				Template: &v2.TemplateRequest{
+					Name: "otp",
+					Language: v2.LanguageRequest{
+						Policy: v2.LanguagePolicyDeterministic,
+						Code:   "id",
+					},
+					Components: []v2.ComponentRequest{},
+				},
+			},
+		},
+		{
+			name: "Template with header, body and buttons",
+			build: func() *v2.RequestMessage {
+				return v2.NewTemplate("refund", "id").
+					Namespace("9898912-121212").
+					QuickReply(1, "refund-no").
+					Body(v2.NewTextParameter("hari ini")).
+					Header(v2.NewDocumentParameter(v2.MediaParameterRequest{Link: "https://flip.id/receipt.pdf"})).
+					Body(v2.NewCurrencyParameterIDR(15000)).
+					URLButton(0, "trx-123").
+					To("6288889999").
+					Build()
+			},
+			expect: &v2.RequestMessage{
+				To:   "6288889999",
+				Type: v2.MessageTypeTemplate,
+				Template: &v2.TemplateRequest{
+					Name: "refund",
+					Language: v2.LanguageRequest{
+						Policy: v2.LanguagePolicyDeterministic,
+						Code:   "id",
+					},
+					Namespace: "9898912-121212",
+					Components: []v2.ComponentRequest{
+						{
+							Type: v2.ComponentTypeHeader,
+							Parameters: []v2.ComponentParameterRequest{
+								{
+									Type:     v2.MessageTypeDocument,
+									Document: &v2.MediaParameterRequest{Link: "https://flip.id/receipt.pdf"},
+								},
+							},
+						},
+						{
+							Type: v2.ComponentTypeBody,
+							Parameters: []v2.ComponentParameterRequest{
+								{Type: v2.MessageTypeText, Text: "hari ini"},
+								v2.NewCurrencyParameterIDR(15000),
+							},
+						},
+						{
+							Type:    v2.ComponentTypeButton,
+							SubType: v2.QuickReply,
+							Parameters: []v2.ComponentParameterRequest{
+								{Type: v2.ParameterTypePayload, Payload: "refund-no"},
+							},
+							Index: &second,
+						},
+						{
+							Type:    v2.ComponentTypeButton,
+							SubType: v2.Url,
+							Parameters: []v2.ComponentParameterRequest{
+								{Type: v2.MessageTypeText, Text: "trx-123"},
+							},
+							Index: &first,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.build())
+		})
+	}
+}
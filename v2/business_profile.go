@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+const (
+	profileAboutPath = "/about"
+	profilePhotoPath = "/photo"
+)
+
+// BusinessProfile is the business profile of Wappin account shown to the customers.
+// About is the text under the business name, Vertical is the industry of the business, e.g. Finance
+type BusinessProfile struct {
+	About       string   `json:"-"`
+	Address     string   `json:"address,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Websites    []string `json:"websites,omitempty"`
+	Vertical    string   `json:"vertical,omitempty"`
+}
+
+// ResponseBusinessProfile is response from Wappin consist the business profile settings
+type ResponseBusinessProfile struct {
+	BaseResponse
+	Settings struct {
+		Business struct {
+			Profile BusinessProfile `json:"profile"`
+		} `json:"business"`
+	} `json:"settings"`
+}
+
+// ResponseProfileAbout is response from Wappin consist the about text of the profile
+type ResponseProfileAbout struct {
+	BaseResponse
+	Settings struct {
+		Profile struct {
+			About ProfileAbout `json:"about"`
+		} `json:"profile"`
+	} `json:"settings"`
+}
+
+// ProfileAbout is the about text of the profile
+type ProfileAbout struct {
+	Text string `json:"text"`
+}
+
+// GetBusinessProfile returns the business profile including the about text.
+func (c *client) GetBusinessProfile(ctx context.Context) (res *BusinessProfile, err error) {
+	var respProfile ResponseBusinessProfile
+	err = c.requestJSON(ctx, http.MethodGet, c.opt.BusinessProfileURL, nil, &respProfile)
+	if err != nil {
+		return
+	}
+
+	var respAbout ResponseProfileAbout
+	err = c.requestJSON(ctx, http.MethodGet, c.opt.ProfileURL+profileAboutPath, nil, &respAbout)
+	if err != nil {
+		return
+	}
+
+	res = &respProfile.Settings.Business.Profile
+	res.About = respAbout.Settings.Profile.About.Text
+	return
+}
+
+// UpdateBusinessProfile updates the business profile, the about text is only updated if it is not empty.
+func (c *client) UpdateBusinessProfile(ctx context.Context, profile *BusinessProfile) (res *BaseResponse, err error) {
+	if profile == nil {
+		err = ErrNilArguments
+		return
+	}
+
+	err = c.requestJSON(ctx, http.MethodPost, c.opt.BusinessProfileURL, profile, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.About == "" {
+		return
+	}
+
+	err = c.requestJSON(ctx, http.MethodPatch, c.opt.ProfileURL+profileAboutPath, &ProfileAbout{Text: profile.About}, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// UploadProfilePhoto uploads the image as the profile photo, the mimeType is detected from the content if it is empty.
+func (c *client) UploadProfilePhoto(ctx context.Context, r io.Reader, mimeType string) (res *BaseResponse, err error) {
+	if r == nil {
+		err = ErrNilArguments
+		return
+	}
+
+	err = c.upload(ctx, c.opt.ProfileURL+profilePhotoPath, r, mimeType, "image/", &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
@@ -0,0 +1,92 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/martian/log"
+)
+
+// CheckContacts checks whether the phone numbers are valid WhatsApp users and returns their WhatsApp ID.
+// The result is returned in the same order as the phones and cached in the Storage for ContactCacheTTL.
+func (c *client) CheckContacts(ctx context.Context, phones ...string) (res []ContactResponse, err error) {
+	if len(phones) == 0 {
+		err = ErrNilArguments
+		return
+	}
+
+	res = make([]ContactResponse, len(phones))
+	indexes := make(map[string][]int, len(phones))
+	uncheckedPhones := make([]string, 0, len(phones))
+	for i, phone := range phones {
+		res[i].Input = phone
+		if contact, ok := c.getCachedContact(ctx, phone); ok {
+			res[i] = contact
+			continue
+		}
+
+		if _, ok := indexes[phone]; !ok {
+			uncheckedPhones = append(uncheckedPhones, phone)
+		}
+
+		indexes[phone] = append(indexes[phone], i)
+	}
+
+	if len(uncheckedPhones) == 0 {
+		return
+	}
+
+	var resp *ResponseContacts
+	err = c.requestJSON(ctx, http.MethodPost, c.opt.ContactsURL, &RequestContacts{
+		Blocking: ContactBlockingWait,
+		Contacts: uncheckedPhones,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contact := range resp.Contacts {
+		for _, i := range indexes[contact.Input] {
+			res[i] = contact
+		}
+
+		if contact.Status == ContactStatusProcessing {
+			continue
+		}
+
+		err = c.opt.Storage.Save(ctx, c.contactCacheKey(contact.Input), contact, c.opt.ContactCacheTTL)
+		if err != nil {
+			log.Errorf("Error caching contact with request_id = %s and input = %s and error = %v", c.getRequestId(ctx), contact.Input, err)
+			err = nil
+		}
+	}
+
+	return
+}
+
+// getCachedContact returns the contact from the Storage, any error from the Storage is treated as a cache miss.
+func (c *client) getCachedContact(ctx context.Context, phone string) (contact ContactResponse, ok bool) {
+	cached, err := c.opt.Storage.Get(ctx, c.contactCacheKey(phone))
+	if err != nil || cached == nil {
+		if err != nil && err != redis.Nil {
+			log.Errorf("Error getting cached contact with request_id = %s and input = %s and error = %v", c.getRequestId(ctx), phone, err)
+		}
+
+		return
+	}
+
+	byteCached, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(byteCached, &contact)
+	ok = err == nil && contact.Input == phone
+	return
+}
+
+func (c *client) contactCacheKey(phone string) string {
+	return c.opt.ContactCacheKeyPrefix + phone
+}
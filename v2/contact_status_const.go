@@ -0,0 +1,12 @@
+package v2
+
+const (
+	ContactStatusValid      = "valid"
+	ContactStatusInvalid    = "invalid"
+	ContactStatusProcessing = "processing"
+)
+
+const (
+	ContactBlockingWait   = "wait"
+	ContactBlockingNoWait = "no_wait"
+)
@@ -2,8 +2,32 @@ package v2
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// List of errors used in this package.
+var (
+	ErrNilArguments         = errors.New("Request nil arguments")
+	ErrUnhealthy            = errors.New("Wappin account is unhealthy")
+	ErrEmptyNamespace       = errors.New("namespace of Wappin account is required")
+	ErrEmptyMedia           = errors.New("media cannot be empty")
+	ErrMediaTooLarge        = errors.New("media exceeds the size limit")
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	ErrTooManyReplyButtons  = errors.New("interactive button message can only have up to 3 reply buttons")
+	ErrInvalidListMessage   = errors.New("invalid interactive list message")
+	ErrInvalidWebhook       = errors.New("invalid webhook from Wappin")
+	ErrInvalidTokenExpiry   = errors.New("invalid token expiry from Wappin")
 )
 
+// errorCodeAccessDenied is the error code from Wappin if the token or the credential is invalid.
+const errorCodeAccessDenied = 1005
+
+// maxErrorBodyLength is the maximum length of the response body put in the details of the status error.
+const maxErrorBodyLength = 512
+
 // Error implements the error interface.
 func (e *Error) Error() string {
 	return fmt.Sprintf("error Wappin code:%d, title:%s and details:%s", e.Code, e.Title, e.Details)
@@ -18,6 +42,16 @@ func CastError(code int, title string, details string) *Error {
 	}
 }
 
+// newStatusError creates the error from the status code and the truncated body if Wappin does not respond with any error.
+func newStatusError(statusCode int, body []byte) *Error {
+	details := strings.TrimSpace(string(body))
+	if len(details) > maxErrorBodyLength {
+		details = details[:maxErrorBodyLength] + "..."
+	}
+
+	return CastError(statusCode, http.StatusText(statusCode), details)
+}
+
 func getError(statusCode int, errors []Error) (err error) {
 	if statusCode == 200 {
 		return
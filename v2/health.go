@@ -0,0 +1,85 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// GatewayStatusConnected is the gateway status when the account is connected to WhatsApp.
+const GatewayStatusConnected = "connected"
+
+// HealthReport is the result of the health check of Wappin account.
+// TokenExpiresAt is zero if the expired time of the token is not cached.
+type HealthReport struct {
+	APIReachable   bool
+	TokenValid     bool
+	TokenExpiresAt time.Time
+	GatewayStatus  string
+	Latency        time.Duration
+}
+
+// Healthy returns true if the API is reachable, the token is valid and the gateway is connected.
+func (h *HealthReport) Healthy() bool {
+	return h.APIReachable && h.TokenValid && h.GatewayStatus == GatewayStatusConnected
+}
+
+// ResponseHealth is response from Wappin consist the health of the account
+type ResponseHealth struct {
+	BaseResponse
+	Health struct {
+		GatewayStatus string `json:"gateway_status"`
+	} `json:"health"`
+}
+
+// Health checks that the token can be obtained and the health endpoint of Wappin is reachable.
+// The report is always returned, the error is returned if the account is not healthy.
+// APIReachable is true if Wappin responds, even with an error, and false only if the request cannot be sent.
+func (c *client) Health(ctx context.Context) (res *HealthReport, err error) {
+	res = new(HealthReport)
+	_, err = c.getToken(ctx)
+	if err != nil {
+		// Wappin rejects the login or responds with an unusable token, so the API is reachable
+		_, res.APIReachable = err.(*Error)
+		res.APIReachable = res.APIReachable || errors.Is(err, ErrInvalidTokenExpiry)
+		return
+	}
+
+	res.TokenValid = true
+	res.TokenExpiresAt = c.getTokenExpiry(ctx)
+
+	var resp ResponseHealth
+	start := time.Now()
+	err = c.requestJSON(ctx, http.MethodGet, c.opt.HealthURL, nil, &resp)
+	res.Latency = time.Since(start)
+	if err != nil {
+		// Wappin responds with an error, so the API is reachable
+		if wappinErr, ok := err.(*Error); ok {
+			res.APIReachable = true
+			res.TokenValid = wappinErr.Code != errorCodeAccessDenied && wappinErr.Code != http.StatusUnauthorized
+		}
+
+		return
+	}
+
+	res.APIReachable = true
+	res.GatewayStatus = resp.Health.GatewayStatus
+	if !res.Healthy() {
+		err = errors.Wrapf(ErrUnhealthy, "gateway status is %q", res.GatewayStatus)
+	}
+
+	return
+}
+
+func (c *client) getTokenExpiry(ctx context.Context) (expiresAt time.Time) {
+	expiry, err := c.opt.Storage.Get(ctx, c.tokenExpiryCacheKey())
+	if err != nil || expiry == nil {
+		return
+	}
+
+	expiresAt, _ = time.Parse(time.RFC3339, fmt.Sprintf("%v", expiry))
+	return
+}
@@ -0,0 +1,27 @@
+package v2
+
+const (
+	InteractiveTypeButton = "button"
+	InteractiveTypeList   = "list"
+)
+
+// List of interactive types of the inbound message when the user replies to an interactive message.
+const (
+	InteractiveTypeButtonReply = "button_reply"
+	InteractiveTypeListReply   = "list_reply"
+)
+
+const (
+	InteractiveButtonTypeReply = "reply"
+)
+
+// List of limits allowed by WhatsApp for interactive messages.
+const (
+	MaxInteractiveReplyButtons     = 3
+	MaxInteractiveListSections     = 10
+	MaxInteractiveListRows         = 10
+	MaxInteractiveListButtonLength = 20
+	MaxInteractiveListTitleLength  = 24
+	MaxInteractiveListRowIdLength  = 200
+	MaxInteractiveListDescLength   = 72
+)
@@ -0,0 +1,5 @@
+package v2
+
+const (
+	LanguagePolicyDeterministic = "deterministic"
+)
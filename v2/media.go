@@ -0,0 +1,59 @@
+package v2
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// List of media size limits allowed by WhatsApp.
+const (
+	MaxMediaImageSize    = 5 << 20
+	MaxMediaVideoSize    = 16 << 20
+	MaxMediaAudioSize    = 16 << 20
+	MaxMediaStickerSize  = 100 << 10
+	MaxMediaDocumentSize = 100 << 20
+)
+
+const mimeTypeSticker = "image/webp"
+
+// MaxMediaSize returns the size limit of the media based on its MIME type.
+func MaxMediaSize(mimeType string) int64 {
+	switch {
+	case mimeType == mimeTypeSticker:
+		return MaxMediaStickerSize
+	case strings.HasPrefix(mimeType, "image/"):
+		return MaxMediaImageSize
+	case strings.HasPrefix(mimeType, "video/"):
+		return MaxMediaVideoSize
+	case strings.HasPrefix(mimeType, "audio/"):
+		return MaxMediaAudioSize
+	default:
+		return MaxMediaDocumentSize
+	}
+}
+
+// detectMediaType detects the MIME type of the data if the mimeType is empty and checks the size limit of the data.
+func detectMediaType(data []byte, mimeType string) (string, error) {
+	if len(data) == 0 {
+		return "", ErrEmptyMedia
+	}
+
+	if mimeType == "" {
+		mimeType = http.DetectContentType(data)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return "", err
+	}
+
+	limit := MaxMediaSize(mediaType)
+	if int64(len(data)) > limit {
+		return "", errors.Wrapf(ErrMediaTooLarge, "%s media is limited to %d bytes", mediaType, limit)
+	}
+
+	return mediaType, nil
+}
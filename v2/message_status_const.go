@@ -0,0 +1,9 @@
+package v2
+
+const (
+	MessageStatusSent      = "sent"
+	MessageStatusDelivered = "delivered"
+	MessageStatusRead      = "read"
+	MessageStatusFailed    = "failed"
+	MessageStatusDeleted   = "deleted"
+)
@@ -4,6 +4,15 @@ const (
 	MessageTypeText        = "text"
 	MessageTypeImage       = "image"
 	MessageTypeVideo       = "video"
+	MessageTypeAudio       = "audio"
+	MessageTypeVoice       = "voice"
+	MessageTypeDocument    = "document"
+	MessageTypeSticker     = "sticker"
+	MessageTypeLocation    = "location"
+	MessageTypeContacts    = "contacts"
+	MessageTypeReaction    = "reaction"
 	MessageTypeTemplate    = "template"
 	MessageTypeInteractive = "interactive"
+	MessageTypeButton      = "button"
+	MessageTypeUnknown     = "unknown"
 )
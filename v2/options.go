@@ -15,24 +15,50 @@ const (
 	DefaultBaseURL = "https://api.chat.wappin.app"
 	// DefaultTimeout is the default timeout of Wappin API.
 	DefaultTimeout = 30 * time.Second
+	// DefaultContactCacheKeyPrefix is the default key prefix for caching the result of checking contacts.
+	DefaultContactCacheKeyPrefix = "wappin:v2:contact:"
+	// DefaultContactCacheTTL is the default TTL for caching the result of checking contacts.
+	DefaultContactCacheTTL = 7 * 24 * time.Hour
+)
+
+// List of default endpoints of Wappin API.
+const (
+	DefaultMediaURL               = "/v1/media"
+	DefaultContactsURL            = "/v1/contacts"
+	DefaultTemplatesURL           = "/v1/message_templates"
+	DefaultBusinessProfileURL     = "/v1/settings/business/profile"
+	DefaultProfileURL             = "/v1/settings/profile"
+	DefaultApplicationSettingsURL = "/v1/settings/application"
+	DefaultHealthURL              = "/v1/health"
 )
 
 // Option is option for initializing Wappin V2 client.
 type Option struct {
-	BaseURL        string
-	LoginURL       string
-	MessagesURL    string
-	Username       string
-	Password       string
-	Namespace      string
-	TokenCacheKey  string
-	Client         heimdall.Doer
-	Timeout        time.Duration
-	HystrixOptions []hystrix.Option
-	Storage        storage.IRedisStorage // the storage using Redis
-	ManagerOptions []manager.FnOption
-	client         *hystrix.Client
-	wappinClient   *client
+	BaseURL                string
+	LoginURL               string
+	MessagesURL            string
+	MediaURL               string
+	ContactsURL            string
+	TemplatesURL           string
+	BusinessProfileURL     string
+	ProfileURL             string // the URL of the profile settings, the about and photo URL are under this URL
+	ApplicationSettingsURL string
+	HealthURL              string
+	Username               string
+	Password               string
+	Namespace              string
+	TokenCacheKey          string
+	Client                 heimdall.Doer
+	Timeout                time.Duration
+	HystrixOptions         []hystrix.Option
+	Storage                storage.IRedisStorage // the storage using Redis
+	ManagerOptions         []manager.FnOption
+	// ContactCacheKeyPrefix and ContactCacheTTL are used for caching the result of checking contacts in the Storage
+	ContactCacheKeyPrefix string
+	ContactCacheTTL       time.Duration
+	SkipValidation        bool // skip the validation of RequestMessage before sending it
+	client                *hystrix.Client
+	wappinClient          *client
 }
 
 // Assign assigns the option to the client.
@@ -63,6 +89,42 @@ func (o *Option) Default() *Option {
 	}
 
 	o.BaseURL = strings.TrimRight(o.BaseURL, "/")
+	if o.MediaURL == "" {
+		o.MediaURL = DefaultMediaURL
+	}
+
+	if o.ContactsURL == "" {
+		o.ContactsURL = DefaultContactsURL
+	}
+
+	if o.TemplatesURL == "" {
+		o.TemplatesURL = DefaultTemplatesURL
+	}
+
+	if o.BusinessProfileURL == "" {
+		o.BusinessProfileURL = DefaultBusinessProfileURL
+	}
+
+	if o.ProfileURL == "" {
+		o.ProfileURL = DefaultProfileURL
+	}
+
+	if o.ApplicationSettingsURL == "" {
+		o.ApplicationSettingsURL = DefaultApplicationSettingsURL
+	}
+
+	if o.HealthURL == "" {
+		o.HealthURL = DefaultHealthURL
+	}
+
+	if o.ContactCacheKeyPrefix == "" {
+		o.ContactCacheKeyPrefix = DefaultContactCacheKeyPrefix
+	}
+
+	if o.ContactCacheTTL <= 0 {
+		o.ContactCacheTTL = DefaultContactCacheTTL
+	}
+
 	if o.Client == nil {
 		o.Client = http.DefaultClient
 	}
@@ -110,6 +172,55 @@ func WithMessagesURL(messagesURL string) FnOption {
 	}
 }
 
+// WithMediaURL sets the Media URL of Wappin API.
+func WithMediaURL(mediaURL string) FnOption {
+	return func(o *Option) {
+		o.MediaURL = mediaURL
+	}
+}
+
+// WithContactsURL sets the Contacts URL of Wappin API.
+func WithContactsURL(contactsURL string) FnOption {
+	return func(o *Option) {
+		o.ContactsURL = contactsURL
+	}
+}
+
+// WithTemplatesURL sets the Message Templates URL of Wappin API.
+func WithTemplatesURL(templatesURL string) FnOption {
+	return func(o *Option) {
+		o.TemplatesURL = templatesURL
+	}
+}
+
+// WithBusinessProfileURL sets the Business Profile URL of Wappin API.
+func WithBusinessProfileURL(businessProfileURL string) FnOption {
+	return func(o *Option) {
+		o.BusinessProfileURL = businessProfileURL
+	}
+}
+
+// WithProfileURL sets the Profile URL of Wappin API.
+func WithProfileURL(profileURL string) FnOption {
+	return func(o *Option) {
+		o.ProfileURL = profileURL
+	}
+}
+
+// WithApplicationSettingsURL sets the Application Settings URL of Wappin API, it is used for the webhook settings.
+func WithApplicationSettingsURL(applicationSettingsURL string) FnOption {
+	return func(o *Option) {
+		o.ApplicationSettingsURL = applicationSettingsURL
+	}
+}
+
+// WithHealthURL sets the Health URL of Wappin API.
+func WithHealthURL(healthURL string) FnOption {
+	return func(o *Option) {
+		o.HealthURL = healthURL
+	}
+}
+
 // WithClient sets the client of Wappin API.
 func WithClient(client heimdall.Doer) FnOption {
 	return func(o *Option) {
@@ -152,6 +263,20 @@ func WithTokenCacheKey(tokenCacheKey string) FnOption {
 	}
 }
 
+// WithContactCacheKeyPrefix set key prefix for caching the result of checking contacts
+func WithContactCacheKeyPrefix(prefix string) FnOption {
+	return func(o *Option) {
+		o.ContactCacheKeyPrefix = prefix
+	}
+}
+
+// WithContactCacheTTL set TTL for caching the result of checking contacts
+func WithContactCacheTTL(ttl time.Duration) FnOption {
+	return func(o *Option) {
+		o.ContactCacheTTL = ttl
+	}
+}
+
 // WithUsername set username for login
 func WithUsername(username string) FnOption {
 	return func(o *Option) {
@@ -166,9 +291,16 @@ func WithPassword(password string) FnOption {
 	}
 }
 
-// WithNamespace set namespace for wappin account
+// WithNamespace set namespace for wappin account, it is used for sending template message and managing message templates
 func WithNamespace(namespace string) FnOption {
 	return func(o *Option) {
 		o.Namespace = namespace
 	}
 }
+
+// WithSkipValidation sets whether the RequestMessage is sent without validation.
+func WithSkipValidation(skipValidation bool) FnOption {
+	return func(o *Option) {
+		o.SkipValidation = skipValidation
+	}
+}
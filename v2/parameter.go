@@ -0,0 +1,118 @@
+package v2
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// CurrencyCodeIDR is the ISO 4217 currency code of Indonesian Rupiah.
+	CurrencyCodeIDR = "IDR"
+	// DefaultDateTimeLayout is the default layout for the fallback value of date_time parameter.
+	DefaultDateTimeLayout = "02 Jan 2006 15:04"
+)
+
+// NewCurrencyParameterIDR creates a currency parameter from the amount of Indonesian Rupiah.
+// The fallback value is formatted as Rp999.999.999.
+func NewCurrencyParameterIDR(amount int64) ComponentParameterRequest {
+	return ComponentParameterRequest{
+		Type: ParameterTypeCurrency,
+		Currency: &CurrencyParameterRequest{
+			FallbackValue: formatIDR(amount),
+			Code:          CurrencyCodeIDR,
+			Amount1000:    amount * 1000,
+		},
+	}
+}
+
+// NewDateTimeParameter creates a localized date_time parameter from the time in its location using the Gregorian calendar.
+// The fallback value is formatted using DefaultDateTimeLayout.
+func NewDateTimeParameter(t time.Time) ComponentParameterRequest {
+	dayOfWeek := int(t.Weekday())
+	if t.Weekday() == time.Sunday {
+		dayOfWeek = 7
+	}
+
+	hour, minute := t.Hour(), t.Minute()
+	return ComponentParameterRequest{
+		Type: ParameterTypeDateTime,
+		DateTime: &DateTimeParameterRequest{
+			FallbackValue: t.Format(DefaultDateTimeLayout),
+			DayOfWeek:     dayOfWeek,
+			Year:          t.Year(),
+			Month:         int(t.Month()),
+			DayOfMonth:    t.Day(),
+			Hour:          &hour,
+			Minute:        &minute,
+			Calendar:      CalendarGregorian,
+		},
+	}
+}
+
+func formatIDR(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := strconv.FormatInt(amount, 10)
+	formatted := make([]byte, 0, len(digits)+len(digits)/3)
+	for i := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			formatted = append(formatted, '.')
+		}
+
+		formatted = append(formatted, digits[i])
+	}
+
+	return sign + "Rp" + string(formatted)
+}
+
+// NewTextParameter creates a text parameter.
+func NewTextParameter(text string) ComponentParameterRequest {
+	return ComponentParameterRequest{
+		Type: MessageTypeText,
+		Text: text,
+	}
+}
+
+// NewImageParameter creates an image parameter, only valid for header component.
+func NewImageParameter(media MediaParameterRequest) ComponentParameterRequest {
+	return ComponentParameterRequest{
+		Type:  MessageTypeImage,
+		Image: &media,
+	}
+}
+
+// NewVideoParameter creates a video parameter, only valid for header component.
+func NewVideoParameter(media MediaParameterRequest) ComponentParameterRequest {
+	return ComponentParameterRequest{
+		Type:  MessageTypeVideo,
+		Video: &media,
+	}
+}
+
+// NewDocumentParameter creates a document parameter, only valid for header component.
+func NewDocumentParameter(media MediaParameterRequest) ComponentParameterRequest {
+	return ComponentParameterRequest{
+		Type:     MessageTypeDocument,
+		Document: &media,
+	}
+}
+
+// NewLocationParameter creates a location parameter, only valid for header component.
+func NewLocationParameter(location LocationRequest) ComponentParameterRequest {
+	return ComponentParameterRequest{
+		Type:     MessageTypeLocation,
+		Location: &location,
+	}
+}
+
+// NewPayloadParameter creates a payload parameter, only valid for quick reply button component.
+func NewPayloadParameter(payload string) ComponentParameterRequest {
+	return ComponentParameterRequest{
+		Type:    ParameterTypePayload,
+		Payload: payload,
+	}
+}
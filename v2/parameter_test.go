@@ -0,0 +1,89 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	v2 "github.com/flip-id/wappin/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCurrencyParameterIDR(t *testing.T) {
+	tt := []struct {
+		name   string
+		amount int64
+		expect v2.ComponentParameterRequest
+	}{
+		{
+			name:   "Zero amount",
+			amount: 0,
+			expect: v2.ComponentParameterRequest{
+				Type: v2.ParameterTypeCurrency,
+				Currency: &v2.CurrencyParameterRequest{
+					FallbackValue: "Rp0",
+					Code:          v2.CurrencyCodeIDR,
+					Amount1000:    0,
+				},
+			},
+		},
+		{
+			name:   "Amount with thousand separators",
+			amount: 999999999,
+			expect: v2.ComponentParameterRequest{
+				Type: v2.ParameterTypeCurrency,
+				Currency: &v2.CurrencyParameterRequest{
+					FallbackValue: "Rp999.999.999",
+					Code:          v2.CurrencyCodeIDR,
+					Amount1000:    999999999000,
+				},
+			},
+		},
+		{
+			name:   "Negative amount",
+			amount: -15000,
+			expect: v2.ComponentParameterRequest{
+				Type: v2.ParameterTypeCurrency,
+				Currency: &v2.CurrencyParameterRequest{
+					FallbackValue: "-Rp15.000",
+					Code:          v2.CurrencyCodeIDR,
+					Amount1000:    -15000000,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, v2.NewCurrencyParameterIDR(tc.amount))
+		})
+	}
+}
+
+func TestNewDateTimeParameter(t *testing.T) {
+	tt := []struct {
+		name   string
+		time   time.Time
+		expect string
+	}{
+		{
+			name:   "Thursday morning",
+			time:   time.Date(2023, time.August, 3, 10, 45, 0, 0, time.UTC),
+			expect: `{"type":"date_time","date_time":{"fallback_value":"03 Aug 2023 10:45","day_of_week":4,"year":2023,"month":8,"day_of_month":3,"hour":10,"minute":45,"calendar":"GREGORIAN"}}`,
+		},
+		{
+			name:   "Sunday midnight",
+			time:   time.Date(2023, time.August, 6, 0, 0, 0, 0, time.FixedZone("WIB", 7*60*60)),
+			expect: `{"type":"date_time","date_time":{"fallback_value":"06 Aug 2023 00:00","day_of_week":7,"year":2023,"month":8,"day_of_month":6,"hour":0,"minute":0,"calendar":"GREGORIAN"}}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(v2.NewDateTimeParameter(tc.time))
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, string(b))
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package v2
+
+const (
+	ParameterTypeCurrency = "currency"
+	ParameterTypeDateTime = "date_time"
+	ParameterTypePayload  = "payload"
+)
+
+const (
+	CalendarGregorian = "GREGORIAN"
+)
@@ -1,12 +1,122 @@
 package v2
 
 // RequestMessage is a request message for sending WhatsApp message.
-// Type is required, the valid value is text, image, video, template and interactive
+// Type is required, the valid value is text, image, video, audio, document, sticker, location, contacts, reaction, template and interactive
+// Only fill the field that matches with the Type, e.g. Text for text message and Document for document message.
+// Context is optional, set it to quote the message that is being replied.
 type RequestMessage struct {
-	To            string          `json:"to"`
-	Type          string          `json:"type"`
-	RecipientType string          `json:"recipient_type,omitempty"`
-	Template      TemplateRequest `json:"template"`
+	To            string                 `json:"to"`
+	Type          string                 `json:"type"`
+	RecipientType string                 `json:"recipient_type,omitempty"`
+	Context       *ContextRequest        `json:"context,omitempty"`
+	Text          *TextRequest           `json:"text,omitempty"`
+	Image         *MediaParameterRequest `json:"image,omitempty"`
+	Video         *MediaParameterRequest `json:"video,omitempty"`
+	Audio         *MediaParameterRequest `json:"audio,omitempty"`
+	Document      *MediaParameterRequest `json:"document,omitempty"`
+	Sticker       *MediaParameterRequest `json:"sticker,omitempty"`
+	Location      *LocationRequest       `json:"location,omitempty"`
+	Contacts      []ContactRequest       `json:"contacts,omitempty"`
+	Reaction      *ReactionRequest       `json:"reaction,omitempty"`
+	Template      *TemplateRequest       `json:"template,omitempty"`
+	Interactive   *InteractiveRequest    `json:"interactive,omitempty"`
+}
+
+// ContextRequest is used to reply a message, MessageId is the ID of the message that is being quoted
+type ContextRequest struct {
+	MessageId string `json:"message_id"`
+}
+
+// ReactionRequest is required to sending Whatsapp message with reaction format
+// MessageId is the ID of the message that is being reacted, set Emoji to empty string to remove the reaction
+type ReactionRequest struct {
+	MessageId string `json:"message_id"`
+	Emoji     string `json:"emoji"`
+}
+
+// Default returns the default request for send Whatsapp message in Wappin.
+func (r *RequestMessage) Default(o *Option) *RequestMessage {
+	if r.Template == nil {
+		return r
+	}
+
+	if r.Template.Namespace == "" {
+		r.Template.Namespace = o.Namespace
+	}
+
+	if r.Template.Language.Policy == "" {
+		r.Template.Language.Policy = LanguagePolicyDeterministic
+	}
+
+	return r
+}
+
+// TextRequest is required to sending Whatsapp message with text format
+// Text message can only be sent inside the 24-hour customer service window
+// PreviewURL is optional, set true to render a preview of the first URL in the Body
+type TextRequest struct {
+	Body       string `json:"body"`
+	PreviewURL bool   `json:"preview_url,omitempty"`
+}
+
+// LocationRequest is required to sending Whatsapp message with location format
+// Longitude and Latitude are required, Name and Address are optional
+type LocationRequest struct {
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	Name      string  `json:"name,omitempty"`
+	Address   string  `json:"address,omitempty"`
+}
+
+// ContactRequest is required to sending Whatsapp message with contacts format, it is sent as a vCard
+// Name is required, FormattedName and at least one of the other name fields must be filled
+// At least one of Phones, Emails or Urls must be filled, the phone number may contain spaces, dashes and parentheses
+type ContactRequest struct {
+	Name   ContactNameRequest    `json:"name"`
+	Org    *ContactOrgRequest    `json:"org,omitempty"`
+	Phones []ContactPhoneRequest `json:"phones,omitempty"`
+	Emails []ContactEmailRequest `json:"emails,omitempty"`
+	Urls   []ContactUrlRequest   `json:"urls,omitempty"`
+}
+
+// ContactNameRequest is the name of the contact
+type ContactNameRequest struct {
+	FormattedName string `json:"formatted_name"`
+	FirstName     string `json:"first_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	MiddleName    string `json:"middle_name,omitempty"`
+	Suffix        string `json:"suffix,omitempty"`
+	Prefix        string `json:"prefix,omitempty"`
+}
+
+// ContactOrgRequest is the organization of the contact
+type ContactOrgRequest struct {
+	Company    string `json:"company,omitempty"`
+	Department string `json:"department,omitempty"`
+	Title      string `json:"title,omitempty"`
+}
+
+// ContactPhoneRequest is the phone number of the contact
+// Type is optional, the common value is CELL, MAIN, IPHONE, HOME and WORK
+// WaId is optional, it is the WhatsApp ID of the phone number
+type ContactPhoneRequest struct {
+	Phone string `json:"phone"`
+	Type  string `json:"type,omitempty"`
+	WaId  string `json:"wa_id,omitempty"`
+}
+
+// ContactEmailRequest is the email of the contact
+// Type is optional, the common value is HOME and WORK
+type ContactEmailRequest struct {
+	Email string `json:"email"`
+	Type  string `json:"type,omitempty"`
+}
+
+// ContactUrlRequest is the URL of the contact
+// Type is optional, the common value is HOME and WORK
+type ContactUrlRequest struct {
+	Url  string `json:"url"`
+	Type string `json:"type,omitempty"`
 }
 
 // TemplateRequest is required to sending Whatsapp message with template format
@@ -26,6 +136,7 @@ type LanguageRequest struct {
 
 // ComponentRequest is required if the template has a dynamic variable value
 // Type is contains valid value header, body and button
+// Header only accepts one parameter, it can be text, image, video, document or location
 // SubType is optional, only used for button template
 // Index is optional, used to set button position, only valid for templates with buttons
 type ComponentRequest struct {
@@ -36,19 +147,126 @@ type ComponentRequest struct {
 }
 
 // ComponentParameterRequest is required if the template has a dynamic variable value
+// Type is required, the valid value is text, currency, date_time, payload, image, audio, video, document and location
+// Image, Audio, Video, Document and Location are only valid for header component
+// Payload is only valid for quick reply button component
 type ComponentParameterRequest struct {
-	Type  string                 `json:"type"`
-	Text  string                 `json:"text,omitempty"`
-	Image *MediaParameterRequest `json:"image,omitempty"`
-	Audio *MediaParameterRequest `json:"audio,omitempty"`
-	Video *MediaParameterRequest `json:"video,omitempty"`
+	Type     string                    `json:"type"`
+	Text     string                    `json:"text,omitempty"`
+	Currency *CurrencyParameterRequest `json:"currency,omitempty"`
+	DateTime *DateTimeParameterRequest `json:"date_time,omitempty"`
+	Payload  string                    `json:"payload,omitempty"`
+	Image    *MediaParameterRequest    `json:"image,omitempty"`
+	Audio    *MediaParameterRequest    `json:"audio,omitempty"`
+	Video    *MediaParameterRequest    `json:"video,omitempty"`
+	Document *MediaParameterRequest    `json:"document,omitempty"`
+	Location *LocationRequest          `json:"location,omitempty"`
+}
+
+// CurrencyParameterRequest is required for currency parameter
+// Amount1000 is the amount multiplied by 1000, FallbackValue is shown when the currency cannot be localized
+type CurrencyParameterRequest struct {
+	FallbackValue string `json:"fallback_value"`
+	Code          string `json:"code"`
+	Amount1000    int64  `json:"amount_1000"`
+}
+
+// DateTimeParameterRequest is required for date_time parameter
+// FallbackValue is shown when the date and time cannot be localized, the other fields are the components to localize it
+// DayOfWeek is 1 for Monday until 7 for Sunday, Hour and Minute are pointers so midnight is still sent
+type DateTimeParameterRequest struct {
+	FallbackValue string `json:"fallback_value"`
+	DayOfWeek     int    `json:"day_of_week,omitempty"`
+	Year          int    `json:"year,omitempty"`
+	Month         int    `json:"month,omitempty"`
+	DayOfMonth    int    `json:"day_of_month,omitempty"`
+	Hour          *int   `json:"hour,omitempty"`
+	Minute        *int   `json:"minute,omitempty"`
+	Calendar      string `json:"calendar,omitempty"`
 }
 
 // MediaParameterRequest is required for Media request, select one Id or Link
-// For Caption and FileName is optional
+// For Caption and FileName is optional, Caption is not supported for audio and sticker
+// and FileName is only used for document
 type MediaParameterRequest struct {
 	Id       string `json:"id,omitempty"`
 	Link     string `json:"link,omitempty"`
 	Caption  string `json:"caption,omitempty"`
-	FileName string `json:"file_name,omitempty"`
+	FileName string `json:"filename,omitempty"`
+}
+
+// InteractiveRequest is required to sending Whatsapp message with interactive format
+// Type is required, the valid value is button and list
+// Header and Footer are optional
+type InteractiveRequest struct {
+	Type   string                    `json:"type"`
+	Header *InteractiveHeaderRequest `json:"header,omitempty"`
+	Body   InteractiveTextRequest    `json:"body"`
+	Footer *InteractiveTextRequest   `json:"footer,omitempty"`
+	Action InteractiveActionRequest  `json:"action"`
+}
+
+// InteractiveHeaderRequest is the header of interactive message
+// Type is required, the valid value is text, image, video and document, select one field that matches with the Type
+type InteractiveHeaderRequest struct {
+	Type     string                 `json:"type"`
+	Text     string                 `json:"text,omitempty"`
+	Image    *MediaParameterRequest `json:"image,omitempty"`
+	Video    *MediaParameterRequest `json:"video,omitempty"`
+	Document *MediaParameterRequest `json:"document,omitempty"`
+}
+
+// InteractiveTextRequest is the text used for body and footer of interactive message
+type InteractiveTextRequest struct {
+	Text string `json:"text"`
+}
+
+// InteractiveActionRequest is the action of interactive message
+// Buttons is required for interactive button message, up to 3 reply buttons
+// Button and Sections are required for interactive list message, Button is the label of the button to open the list
+type InteractiveActionRequest struct {
+	Buttons  []InteractiveButtonRequest  `json:"buttons,omitempty"`
+	Button   string                      `json:"button,omitempty"`
+	Sections []InteractiveSectionRequest `json:"sections,omitempty"`
+}
+
+// InteractiveButtonRequest is the button of interactive button message
+// Type is required, the valid value is reply
+type InteractiveButtonRequest struct {
+	Type  string                  `json:"type"`
+	Reply InteractiveReplyRequest `json:"reply"`
+}
+
+// InteractiveReplyRequest is the reply button, Id is returned in the webhook when the user taps the button
+type InteractiveReplyRequest struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// InteractiveSectionRequest is the section of interactive list message
+// Title is required if the list message has more than one section
+type InteractiveSectionRequest struct {
+	Title string                  `json:"title,omitempty"`
+	Rows  []InteractiveRowRequest `json:"rows"`
+}
+
+// InteractiveRowRequest is the row of interactive list message, Id is returned in the webhook when the user selects the row
+// Description is optional
+type InteractiveRowRequest struct {
+	Id          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// RequestMessageStatus is a request for updating the status of the inbound message, the valid value is read
+type RequestMessageStatus struct {
+	Status string `json:"status"`
+}
+
+// RequestContacts is a request for checking whether the phone numbers are valid WhatsApp users
+// Blocking is wait or no_wait, ForceCheck is used to bypass the cache of Wappin
+type RequestContacts struct {
+	Blocking   string   `json:"blocking"`
+	Contacts   []string `json:"contacts"`
+	ForceCheck bool     `json:"force_check,omitempty"`
 }
@@ -12,6 +12,18 @@ type ResponseMessage struct {
 	Messages []MessageResponse `json:"messages"`
 }
 
+// ResponseMedia is response from Wappin after uploading media
+type ResponseMedia struct {
+	BaseResponse
+	Media []MediaResponse `json:"media"`
+}
+
+// ResponseContacts is response from Wappin after checking contacts
+type ResponseContacts struct {
+	BaseResponse
+	Contacts []ContactResponse `json:"contacts"`
+}
+
 // MetaResponse is version API from Wappin
 type MetaResponse struct {
 	Version string `json:"version"`
@@ -22,6 +34,30 @@ type MessageResponse struct {
 	Id string `json:"id"`
 }
 
+// MediaResponse is media ID from Wappin if you success uploading media
+type MediaResponse struct {
+	Id string `json:"id"`
+}
+
+// MediaInfo is the metadata of the media downloaded from Wappin
+type MediaInfo struct {
+	Id       string
+	MimeType string
+	Size     int64
+}
+
+// ContactResponse is the status of the phone number, WaId is only filled if the Status is valid
+type ContactResponse struct {
+	Input  string `json:"input"`
+	Status string `json:"status"`
+	WaId   string `json:"wa_id,omitempty"`
+}
+
+// IsValid returns true if the phone number is a valid WhatsApp user.
+func (c ContactResponse) IsValid() bool {
+	return c.Status == ContactStatusValid
+}
+
 // Error response if you send invalid request to Wappin or something wrong issue from Wappin
 type Error struct {
 	Code    int    `json:"code"`
@@ -0,0 +1,160 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// MessageTemplate is the message template registered in the namespace of Wappin account.
+// Id, Status, QualityScore and RejectedReason are only filled in the response.
+type MessageTemplate struct {
+	Id             string                `json:"id,omitempty"`
+	Name           string                `json:"name"`
+	Namespace      string                `json:"namespace,omitempty"`
+	Language       string                `json:"language"`
+	Category       TemplateCategory      `json:"category"`
+	Status         TemplateStatus        `json:"status,omitempty"`
+	QualityScore   *TemplateQualityScore `json:"quality_score,omitempty"`
+	RejectedReason string                `json:"rejected_reason,omitempty"`
+	Components     []TemplateComponent   `json:"components"`
+}
+
+// TemplateQualityScore is the quality score of the message template.
+type TemplateQualityScore struct {
+	Score TemplateQuality `json:"score"`
+}
+
+// TemplateComponent is the component of the message template
+// Type is HEADER, BODY, FOOTER or BUTTONS, Format is only used for HEADER, the valid value is TEXT, IMAGE, VIDEO, DOCUMENT and LOCATION
+type TemplateComponent struct {
+	Type    string           `json:"type"`
+	Format  string           `json:"format,omitempty"`
+	Text    string           `json:"text,omitempty"`
+	Buttons []TemplateButton `json:"buttons,omitempty"`
+	Example *TemplateExample `json:"example,omitempty"`
+}
+
+// TemplateButton is the button of the message template, Type is QUICK_REPLY, URL or PHONE_NUMBER
+type TemplateButton struct {
+	Type        string `json:"type"`
+	Text        string `json:"text"`
+	Url         string `json:"url,omitempty"`
+	PhoneNumber string `json:"phone_number,omitempty"`
+}
+
+// TemplateExample is the example value of the variables in the message template, it is required for the review
+type TemplateExample struct {
+	HeaderText   []string   `json:"header_text,omitempty"`
+	HeaderHandle []string   `json:"header_handle,omitempty"`
+	BodyText     [][]string `json:"body_text,omitempty"`
+}
+
+// ResponseTemplates is response from Wappin consist list of message templates
+type ResponseTemplates struct {
+	BaseResponse
+	MessageTemplates []MessageTemplate `json:"message_templates"`
+}
+
+// ResponseCreateTemplate is response from Wappin after creating message template
+type ResponseCreateTemplate struct {
+	BaseResponse
+	Id       string           `json:"id"`
+	Status   TemplateStatus   `json:"status"`
+	Category TemplateCategory `json:"category"`
+}
+
+// ListTemplates returns all message templates in the Namespace.
+func (c *client) ListTemplates(ctx context.Context) (res *ResponseTemplates, err error) {
+	endpoint, err := c.templatesEndpoint("")
+	if err != nil {
+		return
+	}
+
+	err = c.requestJSON(ctx, http.MethodGet, endpoint, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// GetTemplate returns the message templates in the Namespace by its name, one template is returned for each language.
+func (c *client) GetTemplate(ctx context.Context, name string) (res *ResponseTemplates, err error) {
+	if name == "" {
+		err = ErrNilArguments
+		return
+	}
+
+	endpoint, err := c.templatesEndpoint(name)
+	if err != nil {
+		return
+	}
+
+	err = c.requestJSON(ctx, http.MethodGet, endpoint, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// CreateTemplate submits a new message template to the Namespace for review.
+func (c *client) CreateTemplate(ctx context.Context, template *MessageTemplate) (res *ResponseCreateTemplate, err error) {
+	if template == nil {
+		err = ErrNilArguments
+		return
+	}
+
+	endpoint, err := c.templatesEndpoint("")
+	if err != nil {
+		return
+	}
+
+	reqTemplate := *template
+	if reqTemplate.Namespace == "" {
+		reqTemplate.Namespace = c.opt.Namespace
+	}
+
+	err = c.requestJSON(ctx, http.MethodPost, endpoint, &reqTemplate, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// DeleteTemplate deletes the message templates in the Namespace by its name, including all of its languages.
+func (c *client) DeleteTemplate(ctx context.Context, name string) (res *BaseResponse, err error) {
+	if name == "" {
+		err = ErrNilArguments
+		return
+	}
+
+	endpoint, err := c.templatesEndpoint(name)
+	if err != nil {
+		return
+	}
+
+	err = c.requestJSON(ctx, http.MethodDelete, endpoint, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func (c *client) templatesEndpoint(name string) (endpoint string, err error) {
+	if c.opt.Namespace == "" {
+		err = ErrEmptyNamespace
+		return
+	}
+
+	endpoint = c.opt.TemplatesURL
+	if name != "" {
+		endpoint += "/" + url.PathEscape(name)
+	}
+
+	endpoint += "?" + url.Values{"namespace": []string{c.opt.Namespace}}.Encode()
+	return
+}
@@ -0,0 +1,34 @@
+package v2
+
+// TemplateStatus is the review status of the message template.
+type TemplateStatus string
+
+const (
+	TemplateStatusApproved        TemplateStatus = "APPROVED"
+	TemplateStatusPending         TemplateStatus = "PENDING"
+	TemplateStatusRejected        TemplateStatus = "REJECTED"
+	TemplateStatusPaused          TemplateStatus = "PAUSED"
+	TemplateStatusDisabled        TemplateStatus = "DISABLED"
+	TemplateStatusInAppeal        TemplateStatus = "IN_APPEAL"
+	TemplateStatusPendingDeletion TemplateStatus = "PENDING_DELETION"
+	TemplateStatusDeleted         TemplateStatus = "DELETED"
+)
+
+// TemplateCategory is the category of the message template.
+type TemplateCategory string
+
+const (
+	TemplateCategoryMarketing      TemplateCategory = "MARKETING"
+	TemplateCategoryUtility        TemplateCategory = "UTILITY"
+	TemplateCategoryAuthentication TemplateCategory = "AUTHENTICATION"
+)
+
+// TemplateQuality is the quality rating of the message template.
+type TemplateQuality string
+
+const (
+	TemplateQualityGreen   TemplateQuality = "GREEN"
+	TemplateQualityYellow  TemplateQuality = "YELLOW"
+	TemplateQualityRed     TemplateQuality = "RED"
+	TemplateQualityUnknown TemplateQuality = "UNKNOWN"
+)
@@ -0,0 +1,328 @@
+package v2
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// MaxTextParameterLength is the maximum length of the text parameter allowed by WhatsApp.
+const MaxTextParameterLength = 1024
+
+// contactPhonePattern matches the phone number of the contact after removing the spaces, dashes and parentheses, e.g. +62215555555
+var contactPhonePattern = regexp.MustCompile(`^\+?[0-9]{5,15}$`)
+
+// FieldError is the validation error of a field in the RequestMessage.
+// Field is the JSON path of the field, e.g. template.components[0].index
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+// Unwrap returns the underlying error of the field.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError is returned when the RequestMessage is invalid, it lists every invalid field.
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fieldErr.Error()
+	}
+
+	return "invalid request message: " + strings.Join(messages, "; ")
+}
+
+// Is reports whether any of the field errors matches the target.
+func (e *ValidationError) Is(target error) bool {
+	for _, fieldErr := range e.Errors {
+		if errors.Is(fieldErr.Err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *ValidationError) add(field string, err error) {
+	e.Errors = append(e.Errors, &FieldError{
+		Field: field,
+		Err:   err,
+	})
+}
+
+func (e *ValidationError) addf(field string, format string, args ...interface{}) {
+	e.add(field, errors.Errorf(format, args...))
+}
+
+func (e *ValidationError) err() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Validate validates the RequestMessage before sending it to Wappin.
+// It returns *ValidationError listing every invalid field.
+func (r *RequestMessage) Validate() error {
+	v := new(ValidationError)
+	if r.To == "" {
+		v.addf("to", "is required")
+	}
+
+	switch r.Type {
+	case MessageTypeText:
+		if r.Text == nil || r.Text.Body == "" {
+			v.addf("text.body", "is required for text message")
+		}
+	case MessageTypeImage:
+		validateMedia(v, "image", r.Image)
+	case MessageTypeVideo:
+		validateMedia(v, "video", r.Video)
+	case MessageTypeAudio:
+		validateMedia(v, "audio", r.Audio)
+	case MessageTypeDocument:
+		validateMedia(v, "document", r.Document)
+	case MessageTypeSticker:
+		validateMedia(v, "sticker", r.Sticker)
+	case MessageTypeLocation:
+		if r.Location == nil {
+			v.addf("location", "is required for location message")
+		}
+	case MessageTypeContacts:
+		validateContacts(v, r.Contacts)
+	case MessageTypeReaction:
+		if r.Reaction == nil || r.Reaction.MessageId == "" {
+			v.addf("reaction.message_id", "is required for reaction message")
+		}
+	case MessageTypeTemplate:
+		validateTemplate(v, r.Template)
+	case MessageTypeInteractive:
+		validateInteractive(v, r.Interactive)
+	default:
+		v.addf("type", "unknown message type %q", r.Type)
+	}
+
+	return v.err()
+}
+
+func validateMedia(v *ValidationError, field string, media *MediaParameterRequest) {
+	if media == nil {
+		v.addf(field, "is required for %s message", field)
+		return
+	}
+
+	validateMediaParameter(v, field, media)
+}
+
+func validateMediaParameter(v *ValidationError, field string, media *MediaParameterRequest) {
+	switch {
+	case media.Id != "" && media.Link != "":
+		v.addf(field, "only one of id or link can be filled")
+	case media.Id == "" && media.Link == "":
+		v.addf(field, "id or link is required")
+	}
+}
+
+func validateContacts(v *ValidationError, contacts []ContactRequest) {
+	if len(contacts) == 0 {
+		v.addf("contacts", "is required for contacts message")
+		return
+	}
+
+	for i, contact := range contacts {
+		field := fmt.Sprintf("contacts[%d]", i)
+		validateContactName(v, field+".name", contact.Name)
+		if len(contact.Phones) == 0 && len(contact.Emails) == 0 && len(contact.Urls) == 0 {
+			v.addf(field, "at least one of phones, emails or urls is required")
+		}
+
+		for j, phone := range contact.Phones {
+			if !contactPhonePattern.MatchString(strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(phone.Phone)) {
+				v.addf(fmt.Sprintf("%s.phones[%d].phone", field, j), "invalid phone number %q", phone.Phone)
+			}
+		}
+
+		for j, email := range contact.Emails {
+			if _, err := mail.ParseAddress(email.Email); err != nil {
+				v.addf(fmt.Sprintf("%s.emails[%d].email", field, j), "invalid email %q", email.Email)
+			}
+		}
+
+		for j, url := range contact.Urls {
+			if url.Url == "" {
+				v.addf(fmt.Sprintf("%s.urls[%d].url", field, j), "is required")
+			}
+		}
+	}
+}
+
+func validateContactName(v *ValidationError, field string, name ContactNameRequest) {
+	if name.FormattedName == "" {
+		v.addf(field+".formatted_name", "is required")
+	}
+
+	if name.FirstName == "" && name.LastName == "" && name.MiddleName == "" && name.Suffix == "" && name.Prefix == "" {
+		v.addf(field, "at least one of first_name, last_name, middle_name, suffix or prefix is required")
+	}
+}
+
+func validateTemplate(v *ValidationError, template *TemplateRequest) {
+	if template == nil {
+		v.addf("template", "is required for template message")
+		return
+	}
+
+	if template.Name == "" {
+		v.addf("template.name", "is required")
+	}
+
+	if template.Language.Code == "" {
+		v.addf("template.language.code", "is required")
+	}
+
+	for i, component := range template.Components {
+		field := fmt.Sprintf("template.components[%d]", i)
+		if component.Type == ComponentTypeButton {
+			if component.Index == nil {
+				v.addf(field+".index", "is required for button component")
+			}
+
+			if component.SubType == "" {
+				v.addf(field+".sub_type", "is required for button component")
+			}
+		}
+
+		for j := range component.Parameters {
+			validateParameter(v, fmt.Sprintf("%s.parameters[%d]", field, j), &component.Parameters[j])
+		}
+	}
+}
+
+func validateParameter(v *ValidationError, field string, param *ComponentParameterRequest) {
+	switch param.Type {
+	case MessageTypeText:
+		if utf8.RuneCountInString(param.Text) > MaxTextParameterLength {
+			v.addf(field+".text", "is longer than %d characters", MaxTextParameterLength)
+		}
+
+		if strings.ContainsAny(param.Text, "\n\t") {
+			v.addf(field+".text", "cannot contain new line or tab characters")
+		}
+	case MessageTypeImage:
+		validateMedia(v, field+".image", param.Image)
+	case MessageTypeVideo:
+		validateMedia(v, field+".video", param.Video)
+	case MessageTypeAudio:
+		validateMedia(v, field+".audio", param.Audio)
+	case MessageTypeDocument:
+		validateMedia(v, field+".document", param.Document)
+	}
+}
+
+func validateInteractive(v *ValidationError, interactive *InteractiveRequest) {
+	if interactive == nil {
+		v.addf("interactive", "is required for interactive message")
+		return
+	}
+
+	if interactive.Body.Text == "" {
+		v.addf("interactive.body.text", "is required")
+	}
+
+	switch interactive.Type {
+	case InteractiveTypeButton:
+		validateButtons(v, interactive.Action.Buttons)
+	case InteractiveTypeList:
+		validateList(v, &interactive.Action)
+	default:
+		v.addf("interactive.type", "unknown interactive type %q", interactive.Type)
+	}
+}
+
+func validateButtons(v *ValidationError, buttons []InteractiveButtonRequest) {
+	if len(buttons) == 0 {
+		v.addf("interactive.action.buttons", "at least one button is required")
+	}
+
+	if len(buttons) > MaxInteractiveReplyButtons {
+		v.add("interactive.action.buttons", ErrTooManyReplyButtons)
+	}
+
+	for i, button := range buttons {
+		if button.Reply.Id == "" || button.Reply.Title == "" {
+			v.addf(fmt.Sprintf("interactive.action.buttons[%d].reply", i), "id and title are required")
+		}
+	}
+}
+
+func validateList(v *ValidationError, action *InteractiveActionRequest) {
+	listErr := func(field string, format string, args ...interface{}) {
+		v.add(field, errors.Wrapf(ErrInvalidListMessage, format, args...))
+	}
+
+	if action.Button == "" || utf8.RuneCountInString(action.Button) > MaxInteractiveListButtonLength {
+		listErr("interactive.action.button", "is required and up to %d characters", MaxInteractiveListButtonLength)
+	}
+
+	if len(action.Sections) == 0 {
+		listErr("interactive.action.sections", "at least one section is required")
+	}
+
+	if len(action.Sections) > MaxInteractiveListSections {
+		listErr("interactive.action.sections", "can only have up to %d sections", MaxInteractiveListSections)
+	}
+
+	var totalRows int
+	for i, section := range action.Sections {
+		field := fmt.Sprintf("interactive.action.sections[%d]", i)
+		if len(action.Sections) > 1 && section.Title == "" {
+			listErr(field+".title", "is required for multiple sections")
+		}
+
+		if utf8.RuneCountInString(section.Title) > MaxInteractiveListTitleLength {
+			listErr(field+".title", "is longer than %d characters", MaxInteractiveListTitleLength)
+		}
+
+		if len(section.Rows) == 0 {
+			listErr(field+".rows", "at least one row is required")
+		}
+
+		for j, row := range section.Rows {
+			rowField := fmt.Sprintf("%s.rows[%d]", field, j)
+			if row.Id == "" || utf8.RuneCountInString(row.Id) > MaxInteractiveListRowIdLength {
+				listErr(rowField+".id", "is required and up to %d characters", MaxInteractiveListRowIdLength)
+			}
+
+			if row.Title == "" || utf8.RuneCountInString(row.Title) > MaxInteractiveListTitleLength {
+				listErr(rowField+".title", "is required and up to %d characters", MaxInteractiveListTitleLength)
+			}
+
+			if utf8.RuneCountInString(row.Description) > MaxInteractiveListDescLength {
+				listErr(rowField+".description", "is longer than %d characters", MaxInteractiveListDescLength)
+			}
+		}
+
+		totalRows += len(section.Rows)
+	}
+
+	if totalRows > MaxInteractiveListRows {
+		listErr("interactive.action.sections", "can only have up to %d rows", MaxInteractiveListRows)
+	}
+}
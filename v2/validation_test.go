@@ -0,0 +1,185 @@
+package v2_test
+
+import (
+	"strings"
+	"testing"
+
+	v2 "github.com/flip-id/wappin/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestMessageValidate(t *testing.T) {
+	index := 0
+
+	tt := []struct {
+		name         string
+		args         *v2.RequestMessage
+		expectFields []string
+	}{
+		{
+			name: "Valid template message",
+			args: v2.NewTemplate("refund", "id").
+				To("6288889999").
+				Body(v2.NewTextParameter("hari ini")).
+				URLButton(0, "trx-123").
+				Build(),
+		},
+		{
+			name:         "Missing to and unknown type",
+			args:         &v2.RequestMessage{Type: "fax"},
+			expectFields: []string{"to", "type"},
+		},
+		{
+			name: "Template without name and language",
+			args: &v2.RequestMessage{
+				To:       "6288889999",
+				Type:     v2.MessageTypeTemplate,
+				Template: &v2.TemplateRequest{},
+			},
+			expectFields: []string{"template.name", "template.language.code"},
+		},
+		{
+			name: "Template with invalid components",
+			args: &v2.RequestMessage{
+				To:   "6288889999",
+				Type: v2.MessageTypeTemplate,
+				Template: &v2.TemplateRequest{
+					Name:     "refund",
+					Language: v2.LanguageRequest{Code: "id"},
+					Components: []v2.ComponentRequest{
+						{
+							Type: v2.ComponentTypeHeader,
+							Parameters: []v2.ComponentParameterRequest{
+								v2.NewImageParameter(v2.MediaParameterRequest{Id: "media-id", Link: "https://flip.id/image.png"}),
+							},
+						},
+						{
+							Type: v2.ComponentTypeBody,
+							Parameters: []v2.ComponentParameterRequest{
+								v2.NewTextParameter("first line\nsecond line"),
+								v2.NewTextParameter(strings.Repeat("a", v2.MaxTextParameterLength+1)),
+							},
+						},
+						{
+							Type:       v2.ComponentTypeButton,
+							SubType:    v2.QuickReply,
+							Parameters: []v2.ComponentParameterRequest{v2.NewPayloadParameter("refund-yes")},
+						},
+						{
+							Type:       v2.ComponentTypeButton,
+							Parameters: []v2.ComponentParameterRequest{v2.NewTextParameter("trx-123")},
+							Index:      &index,
+						},
+					},
+				},
+			},
+			expectFields: []string{
+				"template.components[0].parameters[0].image",
+				"template.components[1].parameters[0].text",
+				"template.components[1].parameters[1].text",
+				"template.components[2].index",
+				"template.components[3].sub_type",
+			},
+		},
+		{
+			name: "Media message without id and link",
+			args: &v2.RequestMessage{
+				To:       "6288889999",
+				Type:     v2.MessageTypeDocument,
+				Document: &v2.MediaParameterRequest{FileName: "receipt.pdf"},
+			},
+			expectFields: []string{"document"},
+		},
+		{
+			name: "Text message without body",
+			args: &v2.RequestMessage{
+				To:   "6288889999",
+				Type: v2.MessageTypeText,
+			},
+			expectFields: []string{"text.body"},
+		},
+		{
+			name: "Valid contacts message",
+			args: &v2.RequestMessage{
+				To:   "6288889999",
+				Type: v2.MessageTypeContacts,
+				Contacts: []v2.ContactRequest{
+					{
+						Name:   v2.ContactNameRequest{FormattedName: "Flip CS", FirstName: "Flip"},
+						Phones: []v2.ContactPhoneRequest{{Phone: "+62 21 (555) 5555"}},
+					},
+				},
+			},
+		},
+		{
+			name: "Contacts without other name fields and contact details",
+			args: &v2.RequestMessage{
+				To:   "6288889999",
+				Type: v2.MessageTypeContacts,
+				Contacts: []v2.ContactRequest{
+					{Name: v2.ContactNameRequest{FormattedName: "Flip CS"}},
+				},
+			},
+			expectFields: []string{"contacts[0].name", "contacts[0]"},
+		},
+		{
+			name: "Contacts with invalid phone and email",
+			args: &v2.RequestMessage{
+				To:   "6288889999",
+				Type: v2.MessageTypeContacts,
+				Contacts: []v2.ContactRequest{
+					{
+						Name:   v2.ContactNameRequest{FirstName: "Flip"},
+						Phones: []v2.ContactPhoneRequest{{Phone: "+62 21 5555"}, {Phone: "call us"}},
+						Emails: []v2.ContactEmailRequest{{Email: "cs@flip.id"}, {Email: "cs-flip.id"}},
+						Urls:   []v2.ContactUrlRequest{{Url: ""}},
+					},
+				},
+			},
+			expectFields: []string{
+				"contacts[0].name.formatted_name",
+				"contacts[0].phones[1].phone",
+				"contacts[0].emails[1].email",
+				"contacts[0].urls[0].url",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.args.Validate()
+			if len(tc.expectFields) == 0 {
+				assert.Nil(t, err)
+				return
+			}
+
+			validationErr, ok := err.(*v2.ValidationError)
+			if !assert.True(t, ok) {
+				return
+			}
+
+			fields := make([]string, len(validationErr.Errors))
+			for i, fieldErr := range validationErr.Errors {
+				fields[i] = fieldErr.Field
+			}
+
+			assert.Equal(t, tc.expectFields, fields)
+		})
+	}
+}
+
+func TestValidationErrorIs(t *testing.T) {
+	req := &v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeInteractive,
+		Interactive: &v2.InteractiveRequest{
+			Type: v2.InteractiveTypeList,
+			Body: v2.InteractiveTextRequest{Text: "Choose your bank account"},
+		},
+	}
+
+	err := req.Validate()
+	assert.True(t, errors.Is(err, v2.ErrInvalidListMessage))
+	assert.False(t, errors.Is(err, v2.ErrTooManyReplyButtons))
+}
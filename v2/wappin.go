@@ -10,6 +10,8 @@ import (
 	"github.com/pkg/errors"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -19,10 +21,27 @@ const (
 	headerApplicationJSON = "application/json"
 	headerAuthorization   = "Authorization"
 	headerBearer          = "Bearer "
+	tokenExpirySuffix     = ":expired_after"
 )
 
 type Client interface {
 	SendMessage(ctx context.Context, reqMsg *RequestMessage) (res *ResponseMessage, err error)
+	MarkAsRead(ctx context.Context, messageId string) (res *BaseResponse, err error)
+	UploadMedia(ctx context.Context, r io.Reader, mimeType string) (res *ResponseMedia, err error)
+	GetMedia(ctx context.Context, mediaId string, w io.Writer) (res *MediaInfo, err error)
+	DeleteMedia(ctx context.Context, mediaId string) (res *BaseResponse, err error)
+	CheckContacts(ctx context.Context, phones ...string) (res []ContactResponse, err error)
+	ListTemplates(ctx context.Context) (res *ResponseTemplates, err error)
+	GetTemplate(ctx context.Context, name string) (res *ResponseTemplates, err error)
+	CreateTemplate(ctx context.Context, template *MessageTemplate) (res *ResponseCreateTemplate, err error)
+	DeleteTemplate(ctx context.Context, name string) (res *BaseResponse, err error)
+	GetBusinessProfile(ctx context.Context) (res *BusinessProfile, err error)
+	UpdateBusinessProfile(ctx context.Context, profile *BusinessProfile) (res *BaseResponse, err error)
+	UploadProfilePhoto(ctx context.Context, r io.Reader, mimeType string) (res *BaseResponse, err error)
+	GetWebhookSettings(ctx context.Context) (res *WebhookSettings, err error)
+	UpdateWebhookSettings(ctx context.Context, settings *WebhookSettings) (res *BaseResponse, err error)
+	Health(ctx context.Context) (res *HealthReport, err error)
+	Do(ctx context.Context, method string, path string, body interface{}, out interface{}) (err error)
 }
 
 type client struct {
@@ -48,13 +67,23 @@ func (c *client) Assign(o *Option) *client {
 	return c
 }
 
-// SendMessage for sending Whatsapp message to Wappin, currently we only support for message type with template, we can add components are image, video and text in the message.
+// SendMessage for sending Whatsapp message to Wappin, the message can be a template message with components are image, video and text
+// or a free-form text, media and interactive message, which is only allowed inside the 24-hour customer service window.
+// The message is validated before sending, unless the client is created with WithSkipValidation.
 func (c *client) SendMessage(ctx context.Context, reqMsg *RequestMessage) (res *ResponseMessage, err error) {
 	if reqMsg == nil {
-		err = errors.New("Request nil arguments")
+		err = ErrNilArguments
 		return
 	}
 
+	reqMsg = reqMsg.Default(c.opt)
+	if !c.opt.SkipValidation {
+		err = reqMsg.Validate()
+		if err != nil {
+			return
+		}
+	}
+
 	res, err = c.postToWappin(ctx, c.opt.MessagesURL, reqMsg)
 	if err != nil {
 		return
@@ -63,36 +92,59 @@ func (c *client) SendMessage(ctx context.Context, reqMsg *RequestMessage) (res *
 	return res, err
 }
 
-func (c *client) postToWappin(ctx context.Context, endpoint string, body interface{}) (res *ResponseMessage, err error) {
-	requestId := c.getRequestId(ctx)
+// MarkAsRead marks the inbound message as read, so the customer sees the blue ticks on the message.
+func (c *client) MarkAsRead(ctx context.Context, messageId string) (res *BaseResponse, err error) {
+	if messageId == "" {
+		err = ErrNilArguments
+		return
+	}
 
-	var buff bytes.Buffer
-	err = json.NewEncoder(&buff).Encode(body)
+	endpoint := c.opt.MessagesURL + "/" + url.PathEscape(messageId)
+	err = c.requestJSON(ctx, http.MethodPut, endpoint, &RequestMessageStatus{Status: MessageStatusRead}, &res)
 	if err != nil {
-		log.Errorf("Error encoding request body with request_id = %s and payload = %s and error = %v", requestId, buff, err)
+		return nil, err
+	}
+
+	return
+}
+
+// UploadMedia uploads the media to Wappin, the returned media ID can be used as MediaParameterRequest.Id.
+// The mimeType is detected from the content if it is empty, the size of the media is limited based on its type.
+func (c *client) UploadMedia(ctx context.Context, r io.Reader, mimeType string) (res *ResponseMedia, err error) {
+	if r == nil {
+		err = ErrNilArguments
 		return
 	}
 
-	// getting token
-	token, err := c.getToken(ctx)
+	err = c.upload(ctx, c.opt.MediaURL, r, mimeType, "", &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// upload uploads the binary content to Wappin, the accepted MIME type of the content can be limited by the acceptPrefix, e.g. image/.
+func (c *client) upload(ctx context.Context, endpoint string, r io.Reader, mimeType string, acceptPrefix string, res interface{}) (err error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxMediaDocumentSize+1))
 	if err != nil {
-		log.Errorf("Error get token with request_id = %s and payload = %s and error = %v", requestId, buff, err)
 		return
 	}
 
-	// prepare the request
-	url := c.opt.BaseURL + endpoint
-	req, err := http.NewRequest(http.MethodPost, url, &buff)
+	mimeType, err = detectMediaType(data, mimeType)
 	if err != nil {
-		log.Errorf("Error create HTTP request with request_id = %s and payload = %s and error = %v", requestId, buff, err)
 		return
 	}
 
-	// set token to header and do request to Wapppin
-	req.Header.Set(headerAuthorization, headerBearer+token)
-	resp, err := c.opt.client.Do(c.prepareRequest(ctx, req))
+	if !strings.HasPrefix(mimeType, acceptPrefix) {
+		err = errors.Wrapf(ErrUnsupportedMediaType, "expected %s* but got %s", acceptPrefix, mimeType)
+		return
+	}
+
+	requestId := c.getRequestId(ctx)
+	resp, err := c.doRequest(ctx, http.MethodPost, endpoint, mimeType, data)
 	if err != nil {
-		log.Errorf("Error HTTP request with request_id = %s and payload = %s and error = %v", requestId, buff, err)
+		log.Errorf("Error HTTP request upload with request_id = %s and mime_type = %s and error = %v", requestId, mimeType, err)
 		return
 	}
 	defer func() {
@@ -101,20 +153,61 @@ func (c *client) postToWappin(ctx context.Context, endpoint string, body interfa
 		}
 	}()
 
-	byteBody, err := io.ReadAll(resp.Body)
+	err = c.decodeResponse(resp, res)
 	if err != nil {
-		log.Errorf("Error get body response with request_id = %s and payload = %s and error = %v", requestId, buff, err)
+		log.Errorf("Error response upload with request_id = %s and mime_type = %s and error = %v", requestId, mimeType, err)
+	}
+
+	return
+}
+
+// GetMedia downloads the media from Wappin by its ID and streams the content to the w.
+func (c *client) GetMedia(ctx context.Context, mediaId string, w io.Writer) (res *MediaInfo, err error) {
+	if mediaId == "" || w == nil {
+		err = ErrNilArguments
 		return
 	}
 
-	err = json.Unmarshal(byteBody, &res)
+	requestId := c.getRequestId(ctx)
+	resp, err := c.doRequest(ctx, http.MethodGet, c.mediaEndpoint(mediaId), "", nil)
 	if err != nil {
-		log.Errorf("Error unmarshalling response with request_id = %s and payload = %s and error = %v", requestId, buff, err)
+		log.Errorf("Error HTTP request get media with request_id = %s and media_id = %s and error = %v", requestId, mediaId, err)
+		return
+	}
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		err = c.decodeResponse(resp, nil)
+		log.Errorf("Error response get media with request_id = %s and media_id = %s and error = %v", requestId, mediaId, err)
+		return
+	}
+
+	size, err := io.Copy(w, resp.Body)
+	if err != nil {
+		log.Errorf("Error streaming media with request_id = %s and media_id = %s and error = %v", requestId, mediaId, err)
+		return
+	}
+
+	res = &MediaInfo{
+		Id:       mediaId,
+		MimeType: resp.Header.Get(headerContentType),
+		Size:     size,
+	}
+	return
+}
+
+// DeleteMedia deletes the uploaded media from Wappin by its ID.
+func (c *client) DeleteMedia(ctx context.Context, mediaId string) (res *BaseResponse, err error) {
+	if mediaId == "" {
+		err = ErrNilArguments
 		return
 	}
 
-	// casting error from wappin if any error response
-	err = getError(resp.StatusCode, res.Errors)
+	err = c.requestJSON(ctx, http.MethodDelete, c.mediaEndpoint(mediaId), nil, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +215,132 @@ func (c *client) postToWappin(ctx context.Context, endpoint string, body interfa
 	return
 }
 
+func (c *client) mediaEndpoint(mediaId string) string {
+	return c.opt.MediaURL + "/" + url.PathEscape(mediaId)
+}
+
+// Do sends a request to the path of Wappin API using the token from getToken, it can be used for the endpoint that is not supported yet.
+// The body is encoded as JSON if it is not nil and the JSON response is decoded to the out if it is not nil and the status code is 2xx.
+// It returns *Error if Wappin responds with an error or a non-2xx status code, the token is refreshed once on 401 like the other methods.
+func (c *client) Do(ctx context.Context, method string, path string, body interface{}, out interface{}) (err error) {
+	return c.requestJSON(ctx, method, path, body, out)
+}
+
+func (c *client) postToWappin(ctx context.Context, endpoint string, body interface{}) (res *ResponseMessage, err error) {
+	err = c.requestJSON(ctx, http.MethodPost, endpoint, body, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// requestJSON does the request to Wappin with the JSON body and decodes the JSON response to the res.
+func (c *client) requestJSON(ctx context.Context, method string, endpoint string, body interface{}, res interface{}) (err error) {
+	requestId := c.getRequestId(ctx)
+
+	var buff bytes.Buffer
+	if body != nil {
+		err = json.NewEncoder(&buff).Encode(body)
+		if err != nil {
+			log.Errorf("Error encoding request body with request_id = %s and payload = %s and error = %v", requestId, buff.String(), err)
+			return
+		}
+	}
+
+	payload := buff.String()
+	resp, err := c.doRequest(ctx, method, endpoint, headerApplicationJSON, buff.Bytes())
+	if err != nil {
+		log.Errorf("Error HTTP request with request_id = %s and payload = %s and error = %v", requestId, payload, err)
+		return
+	}
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+
+	err = c.decodeResponse(resp, res)
+	if err != nil {
+		log.Errorf("Error response with request_id = %s and payload = %s and error = %v", requestId, payload, err)
+	}
+
+	return
+}
+
+// doRequest does the request to Wappin using the token from getToken, the caller must close the response body.
+// If Wappin responds with 401, e.g. the cached token is revoked, the token is refreshed and the request is retried once.
+func (c *client) doRequest(ctx context.Context, method string, endpoint string, contentType string, body []byte) (resp *http.Response, err error) {
+	// getting token
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return
+	}
+
+	resp, err = c.sendRequest(ctx, method, endpoint, contentType, token, body)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return
+	}
+
+	if resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+
+	token, err = c.login(ctx)
+	if err != nil {
+		return
+	}
+
+	return c.sendRequest(ctx, method, endpoint, contentType, token, body)
+}
+
+func (c *client) sendRequest(ctx context.Context, method string, endpoint string, contentType string, token string, body []byte) (resp *http.Response, err error) {
+	// prepare the request
+	url := c.opt.BaseURL + endpoint
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	// set token to header and do request to Wapppin
+	req = c.prepareRequest(ctx, req)
+	req.Header.Set(headerAuthorization, headerBearer+token)
+	if contentType != "" {
+		req.Header.Set(headerContentType, contentType)
+	}
+
+	resp, err = c.opt.client.Do(req)
+	return
+}
+
+// decodeResponse decodes the JSON response to the res if the status code is 2xx,
+// otherwise it casts the error from Wappin or creates the error from the status code and the body.
+func (c *client) decodeResponse(resp *http.Response, res interface{}) (err error) {
+	byteBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		// the body can be empty or an HTML page from the proxy, so the error is created from the status code
+		var baseResponse BaseResponse
+		if json.Unmarshal(byteBody, &baseResponse) == nil && len(baseResponse.Errors) > 0 {
+			err = getError(resp.StatusCode, baseResponse.Errors)
+			return
+		}
+
+		err = newStatusError(resp.StatusCode, byteBody)
+		return
+	}
+
+	if res == nil || len(bytes.TrimSpace(byteBody)) == 0 {
+		return
+	}
+
+	err = json.Unmarshal(byteBody, res)
+	return
+}
+
 func (c *client) getToken(ctx context.Context) (token string, err error) {
 	// looking for token from cache
 	tokenInterface, err := c.opt.Storage.Get(ctx, c.opt.TokenCacheKey)
@@ -135,6 +354,11 @@ func (c *client) getToken(ctx context.Context) (token string, err error) {
 		return tokenConv, nil
 	}
 
+	return c.login(ctx)
+}
+
+// login gets a new token from Wappin and caches it in the Storage.
+func (c *client) login(ctx context.Context) (token string, err error) {
 	url := c.opt.BaseURL + c.opt.LoginURL
 	req, err := http.NewRequest(http.MethodPost, url, nil)
 	if err != nil {
@@ -152,9 +376,18 @@ func (c *client) getToken(ctx context.Context) (token string, err error) {
 		}
 	}()
 
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+
 	var responseLogin ResponseLogin
-	err = json.NewDecoder(resp.Body).Decode(&responseLogin)
+	err = json.Unmarshal(b, &responseLogin)
 	if err != nil {
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			err = newStatusError(resp.StatusCode, b)
+		}
+
 		return
 	}
 
@@ -172,22 +405,36 @@ func (c *client) getToken(ctx context.Context) (token string, err error) {
 			return "", err
 		}
 
-		return token, err
+		// saving the expired time of the token for the health check, the token is still usable without it
+		err = c.opt.Storage.Save(ctx, c.tokenExpiryCacheKey(), expiredStr, ttlToken)
+		if err != nil {
+			log.Errorf("Error saving token expiry with request_id = %s and error = %v", c.getRequestId(ctx), err)
+		}
+
+		return token, nil
 	}
 
 	err = getError(resp.StatusCode, responseLogin.Errors)
+	if err == nil {
+		err = newStatusError(resp.StatusCode, b)
+	}
+
 	return "", err
 }
 
+func (c *client) tokenExpiryCacheKey() string {
+	return c.opt.TokenCacheKey + tokenExpirySuffix
+}
+
 func (c *client) getTTLToken(expiredStr string) (time.Duration, error) {
-	myTime, err := time.Parse("2006-01-02T15:04:05+07:00", expiredStr)
+	myTime, err := time.Parse(time.RFC3339, expiredStr)
 	if err != nil {
-		panic(err)
+		return 0, errors.Wrapf(ErrInvalidTokenExpiry, "expired_after %q", expiredStr)
 	}
 
 	// reducing the token for one and a half days
 	now := time.Now().Add(time.Hour * 36)
-	return myTime.Sub(now), err
+	return myTime.Sub(now), nil
 }
 
 func (c *client) prepareRequest(ctx context.Context, req *http.Request) *http.Request {
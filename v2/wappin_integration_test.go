@@ -90,7 +90,7 @@ func TestSendMessage(t *testing.T) {
 	req := RequestMessage{
 		To:   os.Getenv("PHONE_NUMBER"),
 		Type: MessageTypeTemplate,
-		Template: TemplateRequest{
+		Template: &TemplateRequest{
 			Name: "testing_webhook_marketing",
 			Language: LanguageRequest{
 				Policy: "deterministic",
@@ -129,7 +129,7 @@ func TestSendMessageWithImage(t *testing.T) {
 	req := RequestMessage{
 		To:   os.Getenv("PHONE_NUMBER"),
 		Type: MessageTypeTemplate,
-		Template: TemplateRequest{
+		Template: &TemplateRequest{
 			Name: "testing_webhook_with_image",
 			Language: LanguageRequest{
 				Policy: "deterministic",
@@ -161,6 +161,64 @@ func TestSendMessageWithImage(t *testing.T) {
 	fmt.Println("Success sending message to Wappin with message ID", resp.Messages[0].Id)
 }
 
+func TestSendMessageWithDocument(t *testing.T) {
+	ctx := context.Background()
+	req := RequestMessage{
+		To:   os.Getenv("PHONE_NUMBER"),
+		Type: MessageTypeTemplate,
+		Template: &TemplateRequest{
+			Name: "testing_webhook_with_document",
+			Language: LanguageRequest{
+				Policy: "deterministic",
+				Code:   "id",
+			},
+			Namespace: os.Getenv("WAPPIN_V2_NAMESPACE"),
+			Components: []ComponentRequest{
+				{
+					Type: ComponentTypeHeader,
+					Parameters: []ComponentParameterRequest{
+						{
+							Type: MessageTypeDocument,
+							Document: &MediaParameterRequest{
+								Link:     "https://www.w3.org/WAI/ER/tests/xhtml/testfiles/resources/pdf/dummy.pdf",
+								FileName: "statement.pdf",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resp, err := c.SendMessage(ctx, &req)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.NotNil(t, resp.Messages[0].Id)
+
+	fmt.Println("Success sending message to Wappin with message ID", resp.Messages[0].Id)
+}
+
+// TestSendTextMessage is only success if the phone number already sent a message in the last 24 hours
+func TestSendTextMessage(t *testing.T) {
+	ctx := context.Background()
+	req := RequestMessage{
+		To:   os.Getenv("PHONE_NUMBER"),
+		Type: MessageTypeText,
+		Text: &TextRequest{
+			Body: "Thanks, we got your ticket",
+		},
+	}
+
+	resp, err := c.SendMessage(ctx, &req)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.NotNil(t, resp.Messages[0].Id)
+
+	fmt.Println("Success sending message to Wappin with message ID", resp.Messages[0].Id)
+}
+
 // TestSendMessageErrorInvalidCredential is use for testing username or password invalid
 // Please change or make empty username or password before run this IT
 func TestSendMessageErrorInvalidCredential(t *testing.T) {
@@ -169,7 +227,7 @@ func TestSendMessageErrorInvalidCredential(t *testing.T) {
 	req := RequestMessage{
 		To:   os.Getenv("PHONE_NUMBER"),
 		Type: MessageTypeTemplate,
-		Template: TemplateRequest{
+		Template: &TemplateRequest{
 			Name: "testing_webhook_with_image",
 			Language: LanguageRequest{
 				Policy: "deterministic",
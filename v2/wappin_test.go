@@ -33,7 +33,7 @@ var (
 	requestSendMessage = v2.RequestMessage{
 		To:   "6288889999",
 		Type: v2.MessageTypeTemplate,
-		Template: v2.TemplateRequest{
+		Template: &v2.TemplateRequest{
 			Name: "testing_webhook_marketing",
 			Language: v2.LanguageRequest{
 				Policy: "deterministic",
@@ -48,9 +48,82 @@ var (
 							Type: v2.MessageTypeText,
 							Text: "hari ini",
 						},
-						{
-							Type: v2.MessageTypeText,
-							Text: "Rp. 999999999",
+						v2.NewCurrencyParameterIDR(999999999),
+					},
+				},
+			},
+		},
+	}
+
+	requestSendTextMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeText,
+		Text: &v2.TextRequest{
+			Body:       "Thanks, we got your ticket https://flip.id/help",
+			PreviewURL: true,
+		},
+	}
+
+	requestSendReplyMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeText,
+		Context: &v2.ContextRequest{
+			MessageId: "wamid.HBgLNjI4ODg4OTk5OQ",
+		},
+		Text: &v2.TextRequest{
+			Body: "Your refund is processed",
+		},
+	}
+
+	requestSendDocumentMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeDocument,
+		Document: &v2.MediaParameterRequest{
+			Link:     "https://flip.id/receipt.pdf",
+			Caption:  "Receipt",
+			FileName: "receipt.pdf",
+		},
+	}
+
+	requestSendInteractiveButtonMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeInteractive,
+		Interactive: &v2.InteractiveRequest{
+			Type: v2.InteractiveTypeButton,
+			Body: v2.InteractiveTextRequest{
+				Text: "Do you want to refund your transaction?",
+			},
+			Action: v2.InteractiveActionRequest{
+				Buttons: []v2.InteractiveButtonRequest{
+					{
+						Type:  v2.InteractiveButtonTypeReply,
+						Reply: v2.InteractiveReplyRequest{Id: "refund-yes", Title: "Yes"},
+					},
+					{
+						Type:  v2.InteractiveButtonTypeReply,
+						Reply: v2.InteractiveReplyRequest{Id: "refund-no", Title: "No"},
+					},
+				},
+			},
+		},
+	}
+
+	requestSendInteractiveListMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeInteractive,
+		Interactive: &v2.InteractiveRequest{
+			Type: v2.InteractiveTypeList,
+			Body: v2.InteractiveTextRequest{
+				Text: "Choose your bank account",
+			},
+			Action: v2.InteractiveActionRequest{
+				Button: "Bank accounts",
+				Sections: []v2.InteractiveSectionRequest{
+					{
+						Title: "Saved accounts",
+						Rows: []v2.InteractiveRowRequest{
+							{Id: "account-1", Title: "BCA", Description: "1234567890"},
+							{Id: "account-2", Title: "Mandiri", Description: "0987654321"},
 						},
 					},
 				},
@@ -58,6 +131,83 @@ var (
 		},
 	}
 
+	requestSendContactsMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeContacts,
+		Contacts: []v2.ContactRequest{
+			{
+				Name: v2.ContactNameRequest{
+					FormattedName: "Flip Customer Service",
+					FirstName:     "Flip",
+				},
+				Org: &v2.ContactOrgRequest{
+					Company: "Flip",
+				},
+				Phones: []v2.ContactPhoneRequest{
+					{Phone: "+62215555555", Type: "WORK"},
+				},
+				Emails: []v2.ContactEmailRequest{
+					{Email: "cs@flip.id", Type: "WORK"},
+				},
+				Urls: []v2.ContactUrlRequest{
+					{Url: "https://flip.id", Type: "WORK"},
+				},
+			},
+		},
+	}
+
+	requestRemoveReactionMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeReaction,
+		Reaction: &v2.ReactionRequest{
+			MessageId: "wamid.HBgLNjI4ODg4OTk5OQ",
+		},
+	}
+
+	requestSendDocumentHeaderMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeTemplate,
+		Template: &v2.TemplateRequest{
+			Name:      "testing_webhook_with_document",
+			Language:  v2.LanguageRequest{Code: "id"},
+			Namespace: "9898912-121212",
+			Components: []v2.ComponentRequest{
+				{
+					Type: v2.ComponentTypeHeader,
+					Parameters: []v2.ComponentParameterRequest{
+						v2.NewDocumentParameter(v2.MediaParameterRequest{
+							Link:     "https://flip.id/receipt.pdf",
+							FileName: "receipt.pdf",
+						}),
+					},
+				},
+			},
+		},
+	}
+
+	requestSendLocationHeaderMessage = v2.RequestMessage{
+		To:   "6288889999",
+		Type: v2.MessageTypeTemplate,
+		Template: &v2.TemplateRequest{
+			Name:      "testing_webhook_with_location",
+			Language:  v2.LanguageRequest{Code: "id"},
+			Namespace: "9898912-121212",
+			Components: []v2.ComponentRequest{
+				{
+					Type: v2.ComponentTypeHeader,
+					Parameters: []v2.ComponentParameterRequest{
+						v2.NewLocationParameter(v2.LocationRequest{
+							Latitude:  -6.2297465,
+							Longitude: 106.829518,
+							Name:      "Flip",
+							Address:   "Jakarta",
+						}),
+					},
+				},
+			},
+		},
+	}
+
 	responseSuccessSendMessage = v2.ResponseMessage{
 		BaseResponse: v2.BaseResponse{
 			Meta: v2.MetaResponse{
@@ -75,11 +225,13 @@ type (
 	response struct {
 		status       int
 		jsonResponse string
+		contentType  string
 	}
 
 	doerMock struct {
 		DoLoginFunc    func(*http.Request) (*response, error)
 		DoMessagesFunc func(*http.Request) (*response, error)
+		DoFunc         func(*http.Request) (*response, error)
 	}
 
 	storageMock struct {
@@ -109,6 +261,7 @@ func (s storageMock) Save(ctx context.Context, key string, i interface{}, ttl ti
 func (d *doerMock) Do(req *http.Request) (*http.Response, error) {
 	url := req.URL.String()
 	status := http.StatusOK
+	contentType := "application/json"
 	var jsonResponse string
 
 	if url == "https://base_url/v1/users/login" {
@@ -136,11 +289,26 @@ func (d *doerMock) Do(req *http.Request) (*http.Response, error) {
 		}
 	}
 
+	if url != "https://base_url/v1/users/login" && url != "https://base_url/v1/messages" {
+		if d.DoFunc != nil {
+			r, err := d.DoFunc(req)
+			if err != nil {
+				return nil, err
+			}
+
+			status = r.status
+			jsonResponse = r.jsonResponse
+			if r.contentType != "" {
+				contentType = r.contentType
+			}
+		}
+	}
+
 	return &http.Response{
 		Status:     fmt.Sprintf("%d", status),
 		StatusCode: status,
 		Header: map[string][]string{
-			"Content-Type": []string{"application/json"},
+			"Content-Type": []string{contentType},
 		},
 		Body: io.NopCloser(strings.NewReader(jsonResponse)),
 	}, nil
@@ -253,6 +421,132 @@ func (ts *wappinTestSuite) TestSendMessage() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "Success send text message",
+			args: func() *v2.RequestMessage {
+				return &requestSendTextMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"text","text":{"body":"Thanks, we got your ticket https://flip.id/help","preview_url":true}}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Success send reply message",
+			args: func() *v2.RequestMessage {
+				return &requestSendReplyMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"text","context":{"message_id":"wamid.HBgLNjI4ODg4OTk5OQ"},"text":{"body":"Your refund is processed"}}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Success send document message",
+			args: func() *v2.RequestMessage {
+				return &requestSendDocumentMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"document","document":{"link":"https://flip.id/receipt.pdf","caption":"Receipt","filename":"receipt.pdf"}}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Success send interactive button message",
+			args: func() *v2.RequestMessage {
+				return &requestSendInteractiveButtonMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"interactive","interactive":{"type":"button","body":{"text":"Do you want to refund your transaction?"},"action":{"buttons":[{"type":"reply","reply":{"id":"refund-yes","title":"Yes"}},{"type":"reply","reply":{"id":"refund-no","title":"No"}}]}}}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Success send interactive list message",
+			args: func() *v2.RequestMessage {
+				return &requestSendInteractiveListMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"interactive","interactive":{"type":"list","body":{"text":"Choose your bank account"},"action":{"button":"Bank accounts","sections":[{"title":"Saved accounts","rows":[{"id":"account-1","title":"BCA","description":"1234567890"},{"id":"account-2","title":"Mandiri","description":"0987654321"}]}]}}}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Success send contacts message",
+			args: func() *v2.RequestMessage {
+				return &requestSendContactsMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"contacts","contacts":[{"name":{"formatted_name":"Flip Customer Service","first_name":"Flip"},"org":{"company":"Flip"},"phones":[{"phone":"+62215555555","type":"WORK"}],"emails":[{"email":"cs@flip.id","type":"WORK"}],"urls":[{"url":"https://flip.id","type":"WORK"}]}]}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Success remove reaction message",
+			args: func() *v2.RequestMessage {
+				return &requestRemoveReactionMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"reaction","reaction":{"message_id":"wamid.HBgLNjI4ODg4OTk5OQ","emoji":""}}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Success send template message with document header",
+			args: func() *v2.RequestMessage {
+				return &requestSendDocumentHeaderMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"template","template":{"name":"testing_webhook_with_document","language":{"policy":"deterministic","code":"id"},"namespace":"9898912-121212","components":[{"type":"header","parameters":[{"type":"document","document":{"link":"https://flip.id/receipt.pdf","filename":"receipt.pdf"}}]}]}}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Success send template message with location header",
+			args: func() *v2.RequestMessage {
+				return &requestSendLocationHeaderMessage
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{DoMessagesFunc: expectMessageBody(`{"to":"6288889999","type":"template","template":{"name":"testing_webhook_with_location","language":{"policy":"deterministic","code":"id"},"namespace":"9898912-121212","components":[{"type":"header","parameters":[{"type":"location","location":{"longitude":106.829518,"latitude":-6.2297465,"name":"Flip","address":"Jakarta"}}]}]}}`)}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return &responseSuccessSendMessage, nil
+			},
+			expectErr: false,
+		},
 		{
 			name: "Error login invalid credential from Wappin",
 			args: func() *v2.RequestMessage {
@@ -335,6 +629,66 @@ func (ts *wappinTestSuite) TestSendMessage() {
 			},
 			expectErr: true,
 		},
+		{
+			name: "Error too many reply buttons",
+			args: func() *v2.RequestMessage {
+				req := requestSendInteractiveButtonMessage
+				interactive := *req.Interactive
+				buttons := make([]v2.InteractiveButtonRequest, v2.MaxInteractiveReplyButtons+1)
+				for i := range buttons {
+					buttons[i] = v2.InteractiveButtonRequest{
+						Type:  v2.InteractiveButtonTypeReply,
+						Reply: v2.InteractiveReplyRequest{Id: fmt.Sprintf("button-%d", i), Title: "Button"},
+					}
+				}
+
+				interactive.Action.Buttons = buttons
+				req.Interactive = &interactive
+				return &req
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return nil, &v2.ValidationError{
+					Errors: []*v2.FieldError{
+						{Field: "interactive.action.buttons", Err: v2.ErrTooManyReplyButtons},
+					},
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "Error too many list rows",
+			args: func() *v2.RequestMessage {
+				req := requestSendInteractiveListMessage
+				interactive := *req.Interactive
+				rows := make([]v2.InteractiveRowRequest, v2.MaxInteractiveListRows+1)
+				for i := range rows {
+					rows[i] = v2.InteractiveRowRequest{Id: fmt.Sprintf("account-%d", i), Title: "Account"}
+				}
+
+				interactive.Action.Sections = []v2.InteractiveSectionRequest{{Title: "Saved accounts", Rows: rows}}
+				req.Interactive = &interactive
+				return &req
+			},
+			mock: func(r *v2.RequestMessage) {
+				ts.doer = &doerMock{}
+				ts.wp = ts.newClient()
+			},
+			expect: func() (*v2.ResponseMessage, error) {
+				return nil, &v2.ValidationError{
+					Errors: []*v2.FieldError{
+						{
+							Field: "interactive.action.sections",
+							Err:   errors.Wrap(v2.ErrInvalidListMessage, "can only have up to 10 rows"),
+						},
+					},
+				}
+			},
+			expectErr: true,
+		},
 		{
 			name: "Error nil arguments",
 			args: func() *v2.RequestMessage {
@@ -390,3 +744,1064 @@ func (ts *wappinTestSuite) TestSendMessage() {
 	}
 
 }
+
+func (ts *wappinTestSuite) TestUploadMedia() {
+	tt := []struct {
+		name      string
+		args      func() (io.Reader, string)
+		mock      func()
+		expect    func() (*v2.ResponseMedia, error)
+		expectErr bool
+	}{
+		{
+			name: "Success upload media with detected MIME type",
+			args: func() (io.Reader, string) {
+				return strings.NewReader("%PDF-1.4 receipt"), ""
+			},
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						if request.URL.String() != "https://base_url/v1/media" || request.Header.Get("Content-Type") != "application/pdf" {
+							return nil, fmt.Errorf("unexpected request %s with content type %s", request.URL, request.Header.Get("Content-Type"))
+						}
+
+						return &response{
+							status:       201,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"media":[{"id":"f043afd0-f0ae-4b9c-ab3d-696fb4c8cd68"}]}`,
+						}, nil
+					},
+				}
+			},
+			expect: func() (*v2.ResponseMedia, error) {
+				return &v2.ResponseMedia{
+					BaseResponse: v2.BaseResponse{
+						Meta: v2.MetaResponse{Version: "1.0.4"},
+					},
+					Media: []v2.MediaResponse{
+						{Id: "f043afd0-f0ae-4b9c-ab3d-696fb4c8cd68"},
+					},
+				}, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Error media too large",
+			args: func() (io.Reader, string) {
+				return strings.NewReader(strings.Repeat("a", v2.MaxMediaStickerSize+1)), "image/webp"
+			},
+			mock: func() {
+				ts.doer = &doerMock{}
+			},
+			expect: func() (*v2.ResponseMedia, error) {
+				return nil, errors.Wrapf(v2.ErrMediaTooLarge, "image/webp media is limited to %d bytes", v2.MaxMediaStickerSize)
+			},
+			expectErr: true,
+		},
+		{
+			name: "Error empty media",
+			args: func() (io.Reader, string) {
+				return strings.NewReader(""), ""
+			},
+			mock: func() {
+				ts.doer = &doerMock{}
+			},
+			expect: func() (*v2.ResponseMedia, error) {
+				return nil, v2.ErrEmptyMedia
+			},
+			expectErr: true,
+		},
+		{
+			name: "Error upload media general from Wappin",
+			args: func() (io.Reader, string) {
+				return strings.NewReader("image"), "image/png"
+			},
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{
+							status:       500,
+							jsonResponse: errorGeneralResponseJson,
+						}, nil
+					},
+				}
+			},
+			expect: func() (*v2.ResponseMedia, error) {
+				return nil, generalErr
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		ts.T().Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			r, mimeType := tc.args()
+			tc.mock()
+			ts.wp = ts.newClient()
+
+			expectResp, expectErr := tc.expect()
+
+			response, err := ts.wp.UploadMedia(ctx, r, mimeType)
+			if tc.expectErr {
+				assert.Equal(t, expectResp, response)
+				assert.Equal(t, expectErr.Error(), err.Error())
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, expectResp, response)
+		})
+	}
+}
+
+func (ts *wappinTestSuite) TestGetMedia() {
+	tt := []struct {
+		name          string
+		mediaId       string
+		mock          func()
+		expect        func() (*v2.MediaInfo, error)
+		expectContent string
+		expectErr     bool
+	}{
+		{
+			name:    "Success get media",
+			mediaId: "f043afd0-f0ae-4b9c-ab3d-696fb4c8cd68",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						if request.Method != http.MethodGet || request.URL.String() != "https://base_url/v1/media/f043afd0-f0ae-4b9c-ab3d-696fb4c8cd68" {
+							return nil, fmt.Errorf("unexpected request %s %s", request.Method, request.URL)
+						}
+
+						return &response{
+							status:       200,
+							jsonResponse: "ktp-image-content",
+							contentType:  "image/jpeg",
+						}, nil
+					},
+				}
+			},
+			expect: func() (*v2.MediaInfo, error) {
+				return &v2.MediaInfo{
+					Id:       "f043afd0-f0ae-4b9c-ab3d-696fb4c8cd68",
+					MimeType: "image/jpeg",
+					Size:     int64(len("ktp-image-content")),
+				}, nil
+			},
+			expectContent: "ktp-image-content",
+			expectErr:     false,
+		},
+		{
+			name:    "Error media not found from Wappin",
+			mediaId: "unknown",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{
+							status:       404,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"errors":[{"code":1009,"title":"Resource not found","details":"Media not found"}]}`,
+						}, nil
+					},
+				}
+			},
+			expect: func() (*v2.MediaInfo, error) {
+				return nil, v2.CastError(1009, "Resource not found", "Media not found")
+			},
+			expectErr: true,
+		},
+		{
+			name:    "Error empty media id",
+			mediaId: "",
+			mock: func() {
+				ts.doer = &doerMock{}
+			},
+			expect: func() (*v2.MediaInfo, error) {
+				return nil, v2.ErrNilArguments
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		ts.T().Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			tc.mock()
+			ts.wp = ts.newClient()
+
+			expectResp, expectErr := tc.expect()
+
+			var buff strings.Builder
+			response, err := ts.wp.GetMedia(ctx, tc.mediaId, &buff)
+			if tc.expectErr {
+				assert.Equal(t, expectResp, response)
+				assert.Equal(t, expectErr.Error(), err.Error())
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, expectResp, response)
+			assert.Equal(t, tc.expectContent, buff.String())
+		})
+	}
+}
+
+func (ts *wappinTestSuite) TestDeleteMedia() {
+	ts.doer = &doerMock{
+		DoFunc: func(request *http.Request) (*response, error) {
+			if request.Method != http.MethodDelete || request.URL.String() != "https://base_url/v1/media/f043afd0-f0ae-4b9c-ab3d-696fb4c8cd68" {
+				return nil, fmt.Errorf("unexpected request %s %s", request.Method, request.URL)
+			}
+
+			return &response{
+				status:       200,
+				jsonResponse: `{"meta":{"version":"1.0.4"}}`,
+			}, nil
+		},
+	}
+	ts.wp = ts.newClient()
+
+	response, err := ts.wp.DeleteMedia(context.Background(), "f043afd0-f0ae-4b9c-ab3d-696fb4c8cd68")
+
+	ts.Nil(err)
+	ts.Equal(&v2.BaseResponse{Meta: v2.MetaResponse{Version: "1.0.4"}}, response)
+}
+
+func (ts *wappinTestSuite) TestDecodeResponse() {
+	tt := []struct {
+		name        string
+		status      int
+		body        string
+		contentType string
+		expect      *v2.BaseResponse
+		expectErr   error
+	}{
+		{
+			name:      "Error empty body 4xx",
+			status:    404,
+			expectErr: v2.CastError(404, "Not Found", ""),
+		},
+		{
+			name:        "Error non-JSON body 5xx",
+			status:      502,
+			body:        "<html><body>" + strings.Repeat("Bad Gateway ", 50) + "</body></html>",
+			contentType: "text/html",
+			expectErr:   v2.CastError(502, "Bad Gateway", "<html><body>"+strings.Repeat("Bad Gateway ", 50)[:500]+"..."),
+		},
+		{
+			name:      "Error JSON body without errors 4xx",
+			status:    403,
+			body:      `{"meta":{"version":"1.0.4"}}`,
+			expectErr: v2.CastError(403, "Forbidden", `{"meta":{"version":"1.0.4"}}`),
+		},
+		{
+			name:   "Success empty body 2xx",
+			status: 200,
+		},
+		{
+			name:   "Success JSON body 2xx",
+			status: 200,
+			body:   `{"meta":{"version":"1.0.4"}}`,
+			expect: &v2.BaseResponse{Meta: v2.MetaResponse{Version: "1.0.4"}},
+		},
+	}
+
+	for _, tc := range tt {
+		ts.T().Run(tc.name, func(t *testing.T) {
+			ts.doer = &doerMock{
+				DoFunc: func(request *http.Request) (*response, error) {
+					return &response{
+						status:       tc.status,
+						jsonResponse: tc.body,
+						contentType:  tc.contentType,
+					}, nil
+				},
+			}
+			ts.wp = ts.newClient()
+
+			response, err := ts.wp.DeleteMedia(context.Background(), "f043afd0-f0ae-4b9c-ab3d-696fb4c8cd68")
+
+			assert.Equal(t, tc.expectErr, err)
+			assert.Equal(t, tc.expect, response)
+		})
+	}
+}
+
+func (ts *wappinTestSuite) TestCheckContacts() {
+	savedKeys := make(map[string]time.Duration)
+	ts.doer = &doerMock{
+		DoFunc: func(request *http.Request) (*response, error) {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			expectBody := `{"blocking":"wait","contacts":["6288880000","6288881111"]}`
+			if request.URL.String() != "https://base_url/v1/contacts" || strings.TrimSpace(string(body)) != expectBody {
+				return nil, fmt.Errorf("unexpected request %s with body %s", request.URL, body)
+			}
+
+			return &response{
+				status:       200,
+				jsonResponse: `{"meta":{"version":"1.0.4"},"contacts":[{"input":"6288880000","status":"valid","wa_id":"6288880000"},{"input":"6288881111","status":"invalid"}]}`,
+			}, nil
+		},
+	}
+	ts.wp = ts.newClientWithStorage(storageMock{
+		GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+			switch key {
+			case tokenCacheKeyMarketing:
+				return wappinToken, nil
+			case v2.DefaultContactCacheKeyPrefix + "6288889999":
+				return map[string]interface{}{"input": "6288889999", "status": "valid", "wa_id": "6288889999"}, nil
+			}
+
+			return nil, redis.Nil
+		},
+		SaveFunc: func(ctx context.Context, key string, i interface{}, ttl time.Duration) (err error) {
+			savedKeys[key] = ttl
+			return nil
+		},
+	}, v2.WithTokenCacheKey(tokenCacheKeyMarketing))
+
+	response, err := ts.wp.CheckContacts(context.Background(), "6288889999", "6288880000", "6288881111", "6288880000")
+
+	ts.Nil(err)
+	ts.Equal([]v2.ContactResponse{
+		{Input: "6288889999", Status: v2.ContactStatusValid, WaId: "6288889999"},
+		{Input: "6288880000", Status: v2.ContactStatusValid, WaId: "6288880000"},
+		{Input: "6288881111", Status: v2.ContactStatusInvalid},
+		{Input: "6288880000", Status: v2.ContactStatusValid, WaId: "6288880000"},
+	}, response)
+	ts.Equal(map[string]time.Duration{
+		v2.DefaultContactCacheKeyPrefix + "6288880000": v2.DefaultContactCacheTTL,
+		v2.DefaultContactCacheKeyPrefix + "6288881111": v2.DefaultContactCacheTTL,
+	}, savedKeys)
+}
+
+func (ts *wappinTestSuite) TestMarkAsRead() {
+	ts.doer = &doerMock{
+		DoFunc: func(request *http.Request) (*response, error) {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			if request.Method != http.MethodPut ||
+				request.URL.String() != "https://base_url/v1/messages/wamid.HBgLNjI4ODg4OTk5OQ" ||
+				strings.TrimSpace(string(body)) != `{"status":"read"}` {
+				return nil, fmt.Errorf("unexpected request %s %s with body %s", request.Method, request.URL, body)
+			}
+
+			return &response{
+				status:       200,
+				jsonResponse: `{"meta":{"version":"1.0.4"}}`,
+			}, nil
+		},
+	}
+	ts.wp = ts.newClient()
+
+	response, err := ts.wp.MarkAsRead(context.Background(), "wamid.HBgLNjI4ODg4OTk5OQ")
+
+	ts.Nil(err)
+	ts.Equal(&v2.BaseResponse{Meta: v2.MetaResponse{Version: "1.0.4"}}, response)
+}
+
+func (ts *wappinTestSuite) TestListTemplates() {
+	tt := []struct {
+		name      string
+		opts      []v2.FnOption
+		mock      func()
+		expect    func() (*v2.ResponseTemplates, error)
+		expectErr bool
+	}{
+		{
+			name: "Success list templates",
+			opts: []v2.FnOption{v2.WithNamespace("9898912-121212")},
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						if request.Method != http.MethodGet || request.URL.String() != "https://base_url/v1/message_templates?namespace=9898912-121212" {
+							return nil, fmt.Errorf("unexpected request %s %s", request.Method, request.URL)
+						}
+
+						return &response{
+							status:       200,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"message_templates":[{"id":"1","name":"otp","namespace":"9898912-121212","language":"id","category":"AUTHENTICATION","status":"APPROVED","quality_score":{"score":"GREEN"},"components":[{"type":"BODY","text":"Your OTP is {{1}}"}]}]}`,
+						}, nil
+					},
+				}
+			},
+			expect: func() (*v2.ResponseTemplates, error) {
+				return &v2.ResponseTemplates{
+					BaseResponse: v2.BaseResponse{
+						Meta: v2.MetaResponse{Version: "1.0.4"},
+					},
+					MessageTemplates: []v2.MessageTemplate{
+						{
+							Id:           "1",
+							Name:         "otp",
+							Namespace:    "9898912-121212",
+							Language:     "id",
+							Category:     v2.TemplateCategoryAuthentication,
+							Status:       v2.TemplateStatusApproved,
+							QualityScore: &v2.TemplateQualityScore{Score: v2.TemplateQualityGreen},
+							Components: []v2.TemplateComponent{
+								{Type: "BODY", Text: "Your OTP is {{1}}"},
+							},
+						},
+					},
+				}, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Error empty namespace",
+			mock: func() {
+				ts.doer = &doerMock{}
+			},
+			expect: func() (*v2.ResponseTemplates, error) {
+				return nil, v2.ErrEmptyNamespace
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		ts.T().Run(tc.name, func(t *testing.T) {
+			tc.mock()
+			ts.wp = ts.newClient(tc.opts...)
+
+			expectResp, expectErr := tc.expect()
+
+			response, err := ts.wp.ListTemplates(context.Background())
+			if tc.expectErr {
+				assert.Equal(t, expectResp, response)
+				assert.Equal(t, expectErr.Error(), err.Error())
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, expectResp, response)
+		})
+	}
+}
+
+func (ts *wappinTestSuite) TestCreateTemplate() {
+	ts.doer = &doerMock{
+		DoFunc: func(request *http.Request) (*response, error) {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			expectBody := `{"name":"refund","namespace":"9898912-121212","language":"id","category":"UTILITY","components":[{"type":"BODY","text":"Your refund of {{1}} is processed"}]}`
+			if request.Method != http.MethodPost || strings.TrimSpace(string(body)) != expectBody {
+				return nil, fmt.Errorf("unexpected request %s %s with body %s", request.Method, request.URL, body)
+			}
+
+			return &response{
+				status:       200,
+				jsonResponse: `{"meta":{"version":"1.0.4"},"id":"2","status":"PENDING","category":"UTILITY"}`,
+			}, nil
+		},
+	}
+	ts.wp = ts.newClient(v2.WithNamespace("9898912-121212"))
+
+	response, err := ts.wp.CreateTemplate(context.Background(), &v2.MessageTemplate{
+		Name:     "refund",
+		Language: "id",
+		Category: v2.TemplateCategoryUtility,
+		Components: []v2.TemplateComponent{
+			{Type: "BODY", Text: "Your refund of {{1}} is processed"},
+		},
+	})
+
+	ts.Nil(err)
+	ts.Equal(&v2.ResponseCreateTemplate{
+		BaseResponse: v2.BaseResponse{Meta: v2.MetaResponse{Version: "1.0.4"}},
+		Id:           "2",
+		Status:       v2.TemplateStatusPending,
+		Category:     v2.TemplateCategoryUtility,
+	}, response)
+}
+
+func (ts *wappinTestSuite) TestGetBusinessProfile() {
+	ts.doer = &doerMock{
+		DoFunc: func(request *http.Request) (*response, error) {
+			switch request.URL.String() {
+			case "https://base_url/v1/settings/business/profile":
+				return &response{
+					status:       200,
+					jsonResponse: `{"meta":{"version":"1.0.4"},"settings":{"business":{"profile":{"address":"Jakarta","description":"Free transfer","email":"cs@flip.id","vertical":"Finance","websites":["https://flip.id"]}}}}`,
+				}, nil
+			case "https://base_url/v1/settings/profile/about":
+				return &response{
+					status:       200,
+					jsonResponse: `{"meta":{"version":"1.0.4"},"settings":{"profile":{"about":{"text":"Flip official account"}}}}`,
+				}, nil
+			}
+
+			return nil, fmt.Errorf("unexpected request %s %s", request.Method, request.URL)
+		},
+	}
+	ts.wp = ts.newClient()
+
+	response, err := ts.wp.GetBusinessProfile(context.Background())
+
+	ts.Nil(err)
+	ts.Equal(&v2.BusinessProfile{
+		About:       "Flip official account",
+		Address:     "Jakarta",
+		Description: "Free transfer",
+		Email:       "cs@flip.id",
+		Websites:    []string{"https://flip.id"},
+		Vertical:    "Finance",
+	}, response)
+}
+
+func (ts *wappinTestSuite) TestUpdateBusinessProfile() {
+	requests := make(map[string]string)
+	ts.doer = &doerMock{
+		DoFunc: func(request *http.Request) (*response, error) {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			requests[request.Method+" "+request.URL.String()] = strings.TrimSpace(string(body))
+			return &response{
+				status:       200,
+				jsonResponse: `{"meta":{"version":"1.0.4"}}`,
+			}, nil
+		},
+	}
+	ts.wp = ts.newClient()
+
+	response, err := ts.wp.UpdateBusinessProfile(context.Background(), &v2.BusinessProfile{
+		About:       "Flip official account",
+		Description: "Free transfer between banks",
+	})
+
+	ts.Nil(err)
+	ts.Equal(&v2.BaseResponse{Meta: v2.MetaResponse{Version: "1.0.4"}}, response)
+	ts.Equal(map[string]string{
+		"POST https://base_url/v1/settings/business/profile": `{"description":"Free transfer between banks"}`,
+		"PATCH https://base_url/v1/settings/profile/about":   `{"text":"Flip official account"}`,
+	}, requests)
+}
+
+func (ts *wappinTestSuite) TestUploadProfilePhotoUnsupportedMediaType() {
+	ts.doer = &doerMock{}
+	ts.wp = ts.newClient()
+
+	response, err := ts.wp.UploadProfilePhoto(context.Background(), strings.NewReader("%PDF-1.4"), "")
+
+	ts.Nil(response)
+	ts.True(errors.Is(err, v2.ErrUnsupportedMediaType))
+}
+
+func (ts *wappinTestSuite) TestDo() {
+	tt := []struct {
+		name      string
+		mock      func()
+		expect    func() (map[string]interface{}, error)
+		expectErr bool
+	}{
+		{
+			name: "Success do",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						body, err := io.ReadAll(request.Body)
+						if err != nil {
+							return nil, err
+						}
+
+						if request.Method != http.MethodPatch ||
+							request.URL.String() != "https://base_url/v1/new-endpoint" ||
+							request.Header.Get("Authorization") != "Bearer "+wappinToken ||
+							strings.TrimSpace(string(body)) != `{"enabled":true}` {
+							return nil, fmt.Errorf("unexpected request %s %s with body %s", request.Method, request.URL, body)
+						}
+
+						return &response{
+							status:       200,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"enabled":true}`,
+						}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return map[string]interface{}{
+					"meta":    map[string]interface{}{"version": "1.0.4"},
+					"enabled": true,
+				}, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Error do general from Wappin",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{
+							status:       500,
+							jsonResponse: errorGeneralResponseJson,
+						}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return nil, generalErr
+			},
+			expectErr: true,
+		},
+		{
+			name: "Success do after refreshing the revoked token",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: successLoginResponseJson,
+						}, nil
+					},
+					DoFunc: func(request *http.Request) (*response, error) {
+						body, err := io.ReadAll(request.Body)
+						if err != nil {
+							return nil, err
+						}
+
+						if strings.TrimSpace(string(body)) != `{"enabled":true}` {
+							return nil, fmt.Errorf("unexpected body %s", body)
+						}
+
+						if request.Header.Get("Authorization") == "Bearer "+wappinToken {
+							return &response{status: 401}, nil
+						}
+
+						return &response{
+							status:       200,
+							jsonResponse: `{"enabled":true}`,
+						}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return map[string]interface{}{"enabled": true}, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "Error do unauthorized with empty body",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: successLoginResponseJson,
+						}, nil
+					},
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{status: 401}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return nil, v2.CastError(401, "Unauthorized", "")
+			},
+			expectErr: true,
+		},
+		{
+			name: "Error do not found with empty body",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return nil, fmt.Errorf("unexpected login")
+					},
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{status: 404}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return nil, v2.CastError(404, "Not Found", "")
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		ts.T().Run(tc.name, func(t *testing.T) {
+			tc.mock()
+			ts.wp = ts.newClient()
+
+			expectOut, expectErr := tc.expect()
+
+			var out map[string]interface{}
+			err := ts.wp.Do(context.Background(), http.MethodPatch, "/v1/new-endpoint", map[string]bool{"enabled": true}, &out)
+			if tc.expectErr {
+				assert.Equal(t, expectErr.Error(), err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, expectOut, out)
+		})
+	}
+}
+
+func (ts *wappinTestSuite) TestWebhookSettings() {
+	requests := make(map[string]string)
+	ts.doer = &doerMock{
+		DoFunc: func(request *http.Request) (*response, error) {
+			if request.URL.String() != "https://base_url/v1/settings/application" {
+				return nil, fmt.Errorf("unexpected request %s %s", request.Method, request.URL)
+			}
+
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			requests[request.Method] = strings.TrimSpace(string(body))
+			return &response{
+				status:       200,
+				jsonResponse: `{"meta":{"version":"1.0.4"},"settings":{"application":{"webhooks":{"url":"https://old.flip.id/webhook","max_concurrent_requests":6},"callback_persist":true}}}`,
+			}, nil
+		},
+	}
+	ts.wp = ts.newClient()
+	callbackPersist := true
+
+	settings, err := ts.wp.GetWebhookSettings(context.Background())
+
+	ts.Nil(err)
+	ts.Equal(&v2.WebhookSettings{
+		Webhooks: &v2.Webhooks{
+			URL:                   "https://old.flip.id/webhook",
+			MaxConcurrentRequests: 6,
+		},
+		CallbackPersist: &callbackPersist,
+	}, settings)
+
+	_, err = ts.wp.UpdateWebhookSettings(context.Background(), &v2.WebhookSettings{
+		Webhooks: &v2.Webhooks{URL: "https://staging.flip.id/webhook"},
+	})
+
+	ts.Nil(err)
+	ts.Equal(`{"webhooks":{"url":"https://staging.flip.id/webhook"}}`, requests[http.MethodPatch])
+}
+
+func (ts *wappinTestSuite) TestHealth() {
+	tokenExpiresAt := time.Date(2023, time.August, 3, 10, 45, 36, 0, time.FixedZone("", 7*60*60))
+
+	tt := []struct {
+		name          string
+		mock          func()
+		expect        *v2.HealthReport
+		expectErr     error
+		expectHealthy bool
+	}{
+		{
+			name: "Healthy account",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"health":{"gateway_status":"connected"}}`,
+						}, nil
+					},
+				}
+				ts.storageMock = storageMock{
+					GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+						if key == tokenCacheKeyMarketing+":expired_after" {
+							return "2023-08-03T10:45:36+07:00", nil
+						}
+
+						return wappinToken, nil
+					},
+				}
+			},
+			expect: &v2.HealthReport{
+				APIReachable:   true,
+				TokenValid:     true,
+				TokenExpiresAt: tokenExpiresAt,
+				GatewayStatus:  v2.GatewayStatusConnected,
+			},
+			expectHealthy: true,
+		},
+		{
+			name: "Disconnected gateway",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"health":{"gateway_status":"disconnected"}}`,
+						}, nil
+					},
+				}
+				ts.storageMock = storageMock{
+					GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+						if key == tokenCacheKeyMarketing {
+							return wappinToken, nil
+						}
+
+						return nil, redis.Nil
+					},
+				}
+			},
+			expect: &v2.HealthReport{
+				APIReachable:  true,
+				TokenValid:    true,
+				GatewayStatus: "disconnected",
+			},
+			expectErr: errors.Wrapf(v2.ErrUnhealthy, "gateway status is %q", "disconnected"),
+		},
+		{
+			name: "Invalid credential",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       401,
+							jsonResponse: errorLoginResponseJson,
+						}, nil
+					},
+				}
+				ts.storageMock = storageMock{
+					GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+						return nil, redis.Nil
+					},
+				}
+			},
+			expect: &v2.HealthReport{
+				APIReachable: true,
+			},
+			expectErr: invalidCredentialErr,
+		},
+		{
+			name: "Unreachable API",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return nil, fmt.Errorf("connection refused")
+					},
+				}
+				ts.storageMock = storageMock{
+					GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+						return nil, redis.Nil
+					},
+				}
+			},
+			expect:    &v2.HealthReport{},
+			expectErr: fmt.Errorf("connection refused"),
+		},
+		{
+			name: "Unauthorized health with empty body",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: successLoginResponseJson,
+						}, nil
+					},
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{status: 401}, nil
+					},
+				}
+				ts.storageMock = storageMock{
+					GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+						if key == tokenCacheKeyMarketing {
+							return wappinToken, nil
+						}
+
+						return nil, redis.Nil
+					},
+					SaveFunc: func(ctx context.Context, key string, i interface{}, ttl time.Duration) (err error) {
+						return nil
+					},
+				}
+			},
+			expect: &v2.HealthReport{
+				APIReachable: true,
+			},
+			expectErr: v2.CastError(401, "Unauthorized", ""),
+		},
+		{
+			name: "Healthy account with UTC token expiry",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: strings.Replace(successLoginResponseJson, "2023-08-03T10:45:36+07:00", "2023-08-03T03:45:36Z", 1),
+						}, nil
+					},
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"health":{"gateway_status":"connected"}}`,
+						}, nil
+					},
+				}
+				ts.storageMock = storageMock{
+					GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+						return nil, redis.Nil
+					},
+					SaveFunc: func(ctx context.Context, key string, i interface{}, ttl time.Duration) (err error) {
+						return nil
+					},
+				}
+			},
+			expect: &v2.HealthReport{
+				APIReachable:  true,
+				TokenValid:    true,
+				GatewayStatus: v2.GatewayStatusConnected,
+			},
+			expectHealthy: true,
+		},
+		{
+			name: "Invalid token expiry",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: strings.Replace(successLoginResponseJson, "2023-08-03T10:45:36+07:00", "2023-08-03 10:45:36", 1),
+						}, nil
+					},
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"health":{"gateway_status":"connected"}}`,
+						}, nil
+					},
+				}
+				ts.storageMock = storageMock{
+					GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+						return nil, redis.Nil
+					},
+					SaveFunc: func(ctx context.Context, key string, i interface{}, ttl time.Duration) (err error) {
+						return nil
+					},
+				}
+			},
+			expect: &v2.HealthReport{
+				APIReachable: true,
+			},
+			expectErr: errors.Wrapf(v2.ErrInvalidTokenExpiry, "expired_after %q", "2023-08-03 10:45:36"),
+		},
+		{
+			name: "Healthy account when saving the token expiry fails",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoLoginFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: successLoginResponseJson,
+						}, nil
+					},
+					DoFunc: func(request *http.Request) (*response, error) {
+						return &response{
+							status:       200,
+							jsonResponse: `{"meta":{"version":"1.0.4"},"health":{"gateway_status":"connected"}}`,
+						}, nil
+					},
+				}
+				ts.storageMock = storageMock{
+					GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+						return nil, redis.Nil
+					},
+					SaveFunc: func(ctx context.Context, key string, i interface{}, ttl time.Duration) (err error) {
+						if key == tokenCacheKeyMarketing+":expired_after" {
+							return fmt.Errorf("redis is down")
+						}
+
+						return nil
+					},
+				}
+			},
+			expect: &v2.HealthReport{
+				APIReachable:  true,
+				TokenValid:    true,
+				GatewayStatus: v2.GatewayStatusConnected,
+			},
+			expectHealthy: true,
+		},
+	}
+
+	for _, tc := range tt {
+		ts.T().Run(tc.name, func(t *testing.T) {
+			tc.mock()
+			ts.wp = ts.newClientWithStorage(ts.storageMock, v2.WithTokenCacheKey(tokenCacheKeyMarketing))
+
+			report, err := ts.wp.Health(context.Background())
+			if tc.expectErr != nil {
+				assert.Equal(t, tc.expectErr.Error(), err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			report.Latency = 0
+			assert.True(t, tc.expect.TokenExpiresAt.Equal(report.TokenExpiresAt))
+			report.TokenExpiresAt = tc.expect.TokenExpiresAt
+			assert.Equal(t, tc.expect, report)
+			assert.Equal(t, tc.expectHealthy, report.Healthy())
+		})
+	}
+}
+
+// newClient initializes the client with the doer mock and the cached token.
+func (ts *wappinTestSuite) newClient(opts ...v2.FnOption) v2.Client {
+	return ts.newClientWithStorage(storageMock{
+		GetFunc: func(ctx context.Context, key string) (i interface{}, err error) {
+			return wappinToken, nil
+		},
+		SaveFunc: func(ctx context.Context, key string, i interface{}, ttl time.Duration) (err error) {
+			return nil
+		},
+	}, opts...)
+}
+
+// newClientWithStorage initializes the client with the doer mock and the storage mock.
+func (ts *wappinTestSuite) newClientWithStorage(s storageMock, opts ...v2.FnOption) v2.Client {
+	ts.storageMock = s
+	return v2.New(
+		append([]v2.FnOption{
+			v2.WithHystrixOptions(hystrix.WithErrorPercentThreshold(100)),
+			v2.WithClient(ts.doer),
+			v2.WithStorage(ts.storageMock),
+			v2.WithBaseURL("https://base_url"),
+			v2.WithLoginURL("/v1/users/login"),
+			v2.WithMessagesURL("/v1/messages"),
+		}, opts...)...,
+	)
+}
+
+// successSendMessage responds the message is sent successfully.
+func successSendMessage(request *http.Request) (*response, error) {
+	return &response{
+		status:       200,
+		jsonResponse: successSendMessageResponseJson,
+	}, nil
+}
+
+// expectMessageBody responds the message is sent successfully only if the request body matches the expectBody.
+func expectMessageBody(expectBody string) func(*http.Request) (*response, error) {
+	return func(request *http.Request) (*response, error) {
+		body, err := io.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(string(body)) != expectBody {
+			return nil, fmt.Errorf("unexpected request body: %s", body)
+		}
+
+		return successSendMessage(request)
+	}
+}
@@ -0,0 +1,338 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flip-id/wappin/internal/receiver"
+	"github.com/flip-id/wappin/verifier"
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+)
+
+// MaxWebhookBodySize is the maximum size of the webhook body read by ParseWebhookRequest.
+const MaxWebhookBodySize = 1 << 20
+
+// Webhook is the payload sent by Wappin to the webhook URL.
+// Messages are the inbound messages from the users, Statuses are the statuses of the sent messages
+// and Contacts are the profiles of the users who sent the Messages.
+type Webhook struct {
+	Contacts []ContactWebhook `json:"contacts,omitempty"`
+	Messages []MessageWebhook `json:"messages,omitempty"`
+	Statuses []StatusWebhook  `json:"statuses,omitempty"`
+	Errors   []Error          `json:"errors,omitempty"`
+}
+
+// ContactWebhook is the profile of the user who sent the inbound message
+type ContactWebhook struct {
+	Profile ProfileWebhook `json:"profile"`
+	WaId    string         `json:"wa_id"`
+}
+
+// ProfileWebhook is the WhatsApp profile of the user
+type ProfileWebhook struct {
+	Name string `json:"name"`
+}
+
+// MessageWebhook is the inbound message from the user, select the field that matches with the Type
+// Context is only filled if the user replies to a message or a button of the message
+// Errors is only filled if the Type is unknown, e.g. the message type is not supported
+type MessageWebhook struct {
+	Id          string              `json:"id"`
+	From        string              `json:"from"`
+	Timestamp   string              `json:"timestamp"`
+	Type        string              `json:"type"`
+	Context     *ContextWebhook     `json:"context,omitempty"`
+	Text        *TextWebhook        `json:"text,omitempty"`
+	Image       *MediaWebhook       `json:"image,omitempty"`
+	Video       *MediaWebhook       `json:"video,omitempty"`
+	Audio       *MediaWebhook       `json:"audio,omitempty"`
+	Voice       *MediaWebhook       `json:"voice,omitempty"`
+	Document    *MediaWebhook       `json:"document,omitempty"`
+	Sticker     *MediaWebhook       `json:"sticker,omitempty"`
+	Location    *LocationWebhook    `json:"location,omitempty"`
+	Contacts    []ContactRequest    `json:"contacts,omitempty"`
+	Button      *ButtonWebhook      `json:"button,omitempty"`
+	Interactive *InteractiveWebhook `json:"interactive,omitempty"`
+	Errors      []Error             `json:"errors,omitempty"`
+}
+
+// Time parses the Timestamp of the message, the Timestamp is in Unix seconds.
+func (m *MessageWebhook) Time() (time.Time, error) {
+	return parseWebhookTimestamp(m.Timestamp)
+}
+
+// Media returns the media of the message based on the Type, it returns nil if the message is not a media message.
+func (m *MessageWebhook) Media() *MediaWebhook {
+	switch m.Type {
+	case MessageTypeImage:
+		return m.Image
+	case MessageTypeVideo:
+		return m.Video
+	case MessageTypeAudio:
+		return m.Audio
+	case MessageTypeVoice:
+		return m.Voice
+	case MessageTypeDocument:
+		return m.Document
+	case MessageTypeSticker:
+		return m.Sticker
+	}
+
+	return nil
+}
+
+// ContextWebhook is the message replied by the user
+// Forwarded is true if the user forwards the message instead of replying to it
+type ContextWebhook struct {
+	Id        string `json:"id"`
+	From      string `json:"from"`
+	Forwarded bool   `json:"forwarded,omitempty"`
+}
+
+// TextWebhook is the body of the inbound text message
+type TextWebhook struct {
+	Body string `json:"body"`
+}
+
+// MediaWebhook is the inbound media, download the media with GetMedia by the Id
+// Caption is only filled for image, video and document, and FileName is only filled for document
+type MediaWebhook struct {
+	Id       string `json:"id"`
+	MimeType string `json:"mime_type"`
+	Sha256   string `json:"sha256"`
+	Caption  string `json:"caption,omitempty"`
+	FileName string `json:"filename,omitempty"`
+}
+
+// LocationWebhook is the location shared by the user, Name, Address and Url are only filled for a place
+type LocationWebhook struct {
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	Name      string  `json:"name,omitempty"`
+	Address   string  `json:"address,omitempty"`
+	Url       string  `json:"url,omitempty"`
+}
+
+// ButtonWebhook is the quick reply button of the template clicked by the user
+// Payload is the payload set in the quick reply parameter and Text is the label of the button
+type ButtonWebhook struct {
+	Payload string `json:"payload"`
+	Text    string `json:"text"`
+}
+
+// InteractiveWebhook is the reply of the interactive message
+// Type is button_reply or list_reply, select the field that matches with the Type
+type InteractiveWebhook struct {
+	Type        string                   `json:"type"`
+	ButtonReply *InteractiveReplyWebhook `json:"button_reply,omitempty"`
+	ListReply   *InteractiveReplyWebhook `json:"list_reply,omitempty"`
+}
+
+// InteractiveReplyWebhook is the button or the row selected by the user, Description is only filled for list_reply
+type InteractiveReplyWebhook struct {
+	Id          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// StatusWebhook is the status of the sent message
+// Status is sent, delivered, read, failed or deleted, Errors is only filled if the Status is failed
+// Conversation and Pricing are not filled for the read status
+type StatusWebhook struct {
+	Id           string               `json:"id"`
+	RecipientId  string               `json:"recipient_id"`
+	Status       string               `json:"status"`
+	Timestamp    string               `json:"timestamp"`
+	Type         string               `json:"type,omitempty"`
+	Conversation *ConversationWebhook `json:"conversation,omitempty"`
+	Pricing      *PricingWebhook      `json:"pricing,omitempty"`
+	Errors       []Error              `json:"errors,omitempty"`
+}
+
+// Time parses the Timestamp of the status, the Timestamp is in Unix seconds.
+func (s *StatusWebhook) Time() (time.Time, error) {
+	return parseWebhookTimestamp(s.Timestamp)
+}
+
+// ConversationWebhook is the conversation where the message is sent
+// ExpirationTimestamp is in Unix seconds and only filled in the first status of the conversation,
+// it is sent either as a number or a string
+type ConversationWebhook struct {
+	Id                  string                     `json:"id"`
+	ExpirationTimestamp json.Number                `json:"expiration_timestamp,omitempty"`
+	Origin              *ConversationOriginWebhook `json:"origin,omitempty"`
+}
+
+// ConversationOriginWebhook is the origin of the conversation, the common value is business_initiated, user_initiated and referral_conversion
+type ConversationOriginWebhook struct {
+	Type string `json:"type"`
+}
+
+// PricingWebhook is the pricing of the conversation
+// PricingModel is CBP for conversation-based pricing, and Category is the same as the Origin of the conversation
+type PricingWebhook struct {
+	Billable     bool   `json:"billable"`
+	PricingModel string `json:"pricing_model"`
+	Category     string `json:"category"`
+}
+
+// ParseWebhook decodes the webhook body from Wappin.
+// The webhook with an invalid timestamp of the messages or the statuses is rejected with ErrInvalidWebhook.
+func ParseWebhook(b []byte) (res *Webhook, err error) {
+	err = json.Unmarshal(b, &res)
+	if err != nil {
+		err = errors.Wrap(ErrInvalidWebhook, err.Error())
+		return nil, err
+	}
+
+	if res == nil {
+		err = errors.Wrap(ErrInvalidWebhook, "empty body")
+		return
+	}
+
+	err = res.validateTimestamps()
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func (wh *Webhook) validateTimestamps() (err error) {
+	for i := range wh.Messages {
+		_, err = wh.Messages[i].Time()
+		if err != nil {
+			return
+		}
+	}
+
+	for i := range wh.Statuses {
+		_, err = wh.Statuses[i].Time()
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// ParseWebhookRequest reads the body of the webhook request from Wappin and decodes it.
+// The body larger than MaxWebhookBodySize is rejected.
+func ParseWebhookRequest(r *http.Request) (res *Webhook, err error) {
+	b, _, err := readWebhookBody(r)
+	if err != nil {
+		return
+	}
+
+	return ParseWebhook(b)
+}
+
+// readWebhookBody reads the webhook body up to MaxWebhookBodySize, the statusCode is responded if the body cannot be read.
+func readWebhookBody(r *http.Request) (b []byte, statusCode int, err error) {
+	if r == nil || r.Body == nil {
+		statusCode = http.StatusBadRequest
+		err = ErrNilArguments
+		return
+	}
+
+	return receiver.ReadBody(r.Body, MaxWebhookBodySize, ErrInvalidWebhook)
+}
+
+// WebhookFunc is the function invoked for every webhook from Wappin.
+type WebhookFunc func(ctx context.Context, wh *Webhook) error
+
+// NewWebhookHandler returns the net/http handler for the v2 webhook from Wappin, only the POST request is accepted.
+// The body over MaxWebhookBodySize is rejected with 413 before it is verified by the opts.
+// The webhook is decoded by ParseWebhook, so the invalid JSON or timestamp is rejected with 400 and never reaches the fn.
+// The fn failure is responded with 500 and Wappin retries the whole webhook, so the fn must tolerate the handled events.
+func NewWebhookHandler(fn WebhookFunc, opts ...verifier.FnOption) http.Handler {
+	o := verifier.NewOption(opts...)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, statusCode, err := readWebhookBody(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		err = o.Verify(r.Context(), verifier.NewHTTPRequest(r, body))
+		if err != nil {
+			statusCode = verifier.StatusCode(err)
+			http.Error(w, http.StatusText(statusCode), statusCode)
+			return
+		}
+
+		wh, err := ParseWebhook(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		statusCode, err = receiver.Invoke(func() error {
+			return fn(r.Context(), wh)
+		})
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.WriteHeader(statusCode)
+	})
+}
+
+// NewFiberWebhookHandler returns the Fiber handler responding the v2 webhook the same way as NewWebhookHandler.
+// The body is read by Fiber first, so the BodyLimit of the Fiber app must not be lower than MaxWebhookBodySize.
+func NewFiberWebhookHandler(fn WebhookFunc, opts ...verifier.FnOption) fiber.Handler {
+	o := verifier.NewOption(opts...)
+	return func(c *fiber.Ctx) error {
+		body := c.Body()
+		statusCode, err := receiver.CheckBodySize(body, MaxWebhookBodySize, ErrInvalidWebhook)
+		if err != nil {
+			return c.Status(statusCode).SendString(err.Error())
+		}
+
+		err = o.Verify(c.UserContext(), verifier.NewFiberRequest(c))
+		if err != nil {
+			return c.SendStatus(verifier.StatusCode(err))
+		}
+
+		wh, err := ParseWebhook(body)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+
+		statusCode, err = receiver.Invoke(func() error {
+			return fn(c.UserContext(), wh)
+		})
+		if err != nil {
+			return c.Status(statusCode).SendString(err.Error())
+		}
+
+		return c.SendStatus(statusCode)
+	}
+}
+
+func parseWebhookTimestamp(timestamp string) (t time.Time, err error) {
+	timestamp = strings.TrimSpace(timestamp)
+	if timestamp == "" {
+		return
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		err = errors.Wrapf(ErrInvalidWebhook, "invalid timestamp %q", timestamp)
+		return
+	}
+
+	t = time.Unix(unix, 0)
+	return
+}
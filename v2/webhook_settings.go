@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+)
+
+// WebhookSettings is the webhook settings of Wappin account, only the filled fields are updated.
+// CallbackPersist keeps the callbacks when the webhook is down, SentStatus enables the sent status callback.
+type WebhookSettings struct {
+	Webhooks        *Webhooks `json:"webhooks,omitempty"`
+	CallbackPersist *bool     `json:"callback_persist,omitempty"`
+	SentStatus      *bool     `json:"sent_status,omitempty"`
+}
+
+// Webhooks is the URL where Wappin sends the inbound messages and statuses.
+// MaxConcurrentRequests is optional, the valid value is 6, 12, 18 or 24
+type Webhooks struct {
+	URL                   string `json:"url"`
+	MaxConcurrentRequests int    `json:"max_concurrent_requests,omitempty"`
+}
+
+// ResponseApplicationSettings is response from Wappin consist the application settings
+type ResponseApplicationSettings struct {
+	BaseResponse
+	Settings struct {
+		Application WebhookSettings `json:"application"`
+	} `json:"settings"`
+}
+
+// GetWebhookSettings returns the webhook settings of the account.
+func (c *client) GetWebhookSettings(ctx context.Context) (res *WebhookSettings, err error) {
+	var resp ResponseApplicationSettings
+	err = c.requestJSON(ctx, http.MethodGet, c.opt.ApplicationSettingsURL, nil, &resp)
+	if err != nil {
+		return
+	}
+
+	res = &resp.Settings.Application
+	return
+}
+
+// UpdateWebhookSettings updates the webhook settings of the account, e.g. pointing the webhook URL to the current environment.
+func (c *client) UpdateWebhookSettings(ctx context.Context, settings *WebhookSettings) (res *BaseResponse, err error) {
+	if settings == nil {
+		err = ErrNilArguments
+		return
+	}
+
+	err = c.requestJSON(ctx, http.MethodPatch, c.opt.ApplicationSettingsURL, settings, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
@@ -0,0 +1,230 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v2 "github.com/flip-id/wappin/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	statusWebhookJson = `{
+		"statuses": [{
+			"id": "gBEGkYiEB1VXAglK1ZEqA1YKPrU",
+			"recipient_id": "6288889999",
+			"status": "failed",
+			"timestamp": "1691034336",
+			"type": "message",
+			"conversation": {
+				"id": "532b57b5f6e63595ccd74c6010e5c5c7",
+				"expiration_timestamp": "1691120736",
+				"origin": {"type": "business_initiated"}
+			},
+			"pricing": {"billable": true, "pricing_model": "CBP", "category": "business_initiated"},
+			"errors": [{"code": 470, "title": "Message failed to send because more than 24 hours have passed"}]
+		}]
+	}`
+	messageWebhookJson = `{
+		"contacts": [{"profile": {"name": "Budi"}, "wa_id": "6288889999"}],
+		"messages": [
+			{
+				"from": "6288889999",
+				"id": "ABGGFlA5FpafAgo6EhoD",
+				"timestamp": "1691034336",
+				"type": "text",
+				"text": {"body": "halo"}
+			},
+			{
+				"from": "6288889999",
+				"id": "ABGGFlA5FpafAgo6EhoE",
+				"timestamp": "1691034337",
+				"type": "image",
+				"context": {"from": "6281111111", "id": "gBEGkYiEB1VXAglK1ZEqA1YKPrU"},
+				"image": {"id": "image-id", "mime_type": "image/jpeg", "sha256": "abc", "caption": "bukti transfer"}
+			},
+			{
+				"from": "6288889999",
+				"id": "ABGGFlA5FpafAgo6EhoF",
+				"timestamp": "1691034338",
+				"type": "button",
+				"button": {"payload": "refund-yes", "text": "Ya"}
+			},
+			{
+				"from": "6288889999",
+				"id": "ABGGFlA5FpafAgo6EhoG",
+				"timestamp": "1691034339",
+				"type": "interactive",
+				"interactive": {"type": "list_reply", "list_reply": {"id": "bank-bca", "title": "BCA", "description": "Bank Central Asia"}}
+			}
+		]
+	}`
+)
+
+func TestParseWebhook(t *testing.T) {
+	t.Run("Success parse statuses", func(t *testing.T) {
+		res, err := v2.ParseWebhook([]byte(statusWebhookJson))
+
+		assert.Nil(t, err)
+		assert.Len(t, res.Statuses, 1)
+
+		status := res.Statuses[0]
+		assert.Equal(t, v2.MessageStatusFailed, status.Status)
+		assert.Equal(t, json.Number("1691120736"), status.Conversation.ExpirationTimestamp)
+		assert.Equal(t, "business_initiated", status.Conversation.Origin.Type)
+		assert.Equal(t, &v2.PricingWebhook{Billable: true, PricingModel: "CBP", Category: "business_initiated"}, status.Pricing)
+		assert.Equal(t, 470, status.Errors[0].Code)
+
+		statusTime, err := status.Time()
+		assert.Nil(t, err)
+		assert.True(t, time.Unix(1691034336, 0).Equal(statusTime))
+	})
+
+	t.Run("Success parse messages", func(t *testing.T) {
+		res, err := v2.ParseWebhook([]byte(messageWebhookJson))
+
+		assert.Nil(t, err)
+		assert.Equal(t, []v2.ContactWebhook{{Profile: v2.ProfileWebhook{Name: "Budi"}, WaId: "6288889999"}}, res.Contacts)
+		assert.Len(t, res.Messages, 4)
+
+		assert.Equal(t, &v2.TextWebhook{Body: "halo"}, res.Messages[0].Text)
+		assert.Nil(t, res.Messages[0].Media())
+
+		assert.Equal(t, &v2.ContextWebhook{From: "6281111111", Id: "gBEGkYiEB1VXAglK1ZEqA1YKPrU"}, res.Messages[1].Context)
+		assert.Equal(t, &v2.MediaWebhook{Id: "image-id", MimeType: "image/jpeg", Sha256: "abc", Caption: "bukti transfer"}, res.Messages[1].Media())
+
+		assert.Equal(t, &v2.ButtonWebhook{Payload: "refund-yes", Text: "Ya"}, res.Messages[2].Button)
+
+		assert.Equal(t, v2.InteractiveTypeListReply, res.Messages[3].Interactive.Type)
+		assert.Equal(t, &v2.InteractiveReplyWebhook{Id: "bank-bca", Title: "BCA", Description: "Bank Central Asia"}, res.Messages[3].Interactive.ListReply)
+	})
+
+	t.Run("Error invalid json", func(t *testing.T) {
+		res, err := v2.ParseWebhook([]byte(`{"messages":`))
+
+		assert.True(t, errors.Is(err, v2.ErrInvalidWebhook))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Error invalid timestamp of the status", func(t *testing.T) {
+		res, err := v2.ParseWebhook([]byte(`{"statuses":[{"id":"status-id","status":"read","timestamp":"yesterday"}]}`))
+
+		assert.True(t, errors.Is(err, v2.ErrInvalidWebhook))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Error invalid timestamp", func(t *testing.T) {
+		message := v2.MessageWebhook{Timestamp: "yesterday"}
+
+		_, err := message.Time()
+
+		assert.True(t, errors.Is(err, v2.ErrInvalidWebhook))
+	})
+}
+
+func TestParseWebhookRequest(t *testing.T) {
+	t.Run("Success parse request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(messageWebhookJson))
+
+		res, err := v2.ParseWebhookRequest(req)
+
+		assert.Nil(t, err)
+		assert.Len(t, res.Messages, 4)
+	})
+
+	t.Run("Error body too large", func(t *testing.T) {
+		body := `{"messages":[],"padding":"` + strings.Repeat("a", v2.MaxWebhookBodySize) + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+
+		res, err := v2.ParseWebhookRequest(req)
+
+		assert.True(t, errors.Is(err, v2.ErrInvalidWebhook))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Error nil request", func(t *testing.T) {
+		res, err := v2.ParseWebhookRequest(nil)
+
+		assert.Equal(t, v2.ErrNilArguments, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestWebhookHandlers(t *testing.T) {
+	tt := []struct {
+		name         string
+		body         string
+		fn           v2.WebhookFunc
+		expectStatus int
+	}{
+		{
+			name: "Success handle webhook",
+			body: messageWebhookJson,
+			fn: func(ctx context.Context, wh *v2.Webhook) error {
+				if len(wh.Messages) != 4 {
+					return errors.New("unexpected webhook")
+				}
+
+				return nil
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error decode webhook",
+			body: `not json`,
+			fn: func(ctx context.Context, wh *v2.Webhook) error {
+				return errors.New("must not be called")
+			},
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error from webhook func",
+			body: statusWebhookJson,
+			fn: func(ctx context.Context, wh *v2.Webhook) error {
+				return errors.New("database is down")
+			},
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Error body too large",
+			body: `{"padding":"` + strings.Repeat("a", v2.MaxWebhookBodySize) + `"}`,
+			fn: func(ctx context.Context, wh *v2.Webhook) error {
+				return errors.New("must not be called")
+			},
+			expectStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run("net/http "+tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(tc.body))
+
+			v2.NewWebhookHandler(tc.fn).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectStatus, rec.Code)
+			assert.NotContains(t, rec.Body.String(), "database is down")
+		})
+
+		t.Run("fiber "+tc.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Post("/webhook", v2.NewFiberWebhookHandler(tc.fn))
+			req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(tc.body))
+
+			resp, err := app.Test(req)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectStatus, resp.StatusCode)
+			body, _ := io.ReadAll(resp.Body)
+			assert.NotContains(t, string(body), "database is down")
+		})
+	}
+}
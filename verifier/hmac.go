@@ -0,0 +1,38 @@
+package verifier
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// DefaultSignatureHeader is the default header of the HMAC signature.
+const DefaultSignatureHeader = "X-Wappin-Signature"
+
+// signaturePrefix is the optional prefix of the signature, e.g. sha256=5d5b09f6...
+const signaturePrefix = "sha256="
+
+// NewHMACVerifier creates the Verifier checking the hex-encoded HMAC-SHA256 of the body in the header with the shared secret.
+// The header is DefaultSignatureHeader if it is empty, every request is rejected if the secret is empty.
+func NewHMACVerifier(header string, secret []byte) Verifier {
+	if header == "" {
+		header = DefaultSignatureHeader
+	}
+
+	return VerifierFunc(func(r *Request) error {
+		signature := strings.TrimPrefix(strings.TrimSpace(r.Header.Get(header)), signaturePrefix)
+		got, err := hex.DecodeString(strings.ToLower(signature))
+		if err != nil {
+			got = nil
+		}
+
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(r.Body)
+		if len(secret) == 0 || !hmac.Equal(got, mac.Sum(nil)) {
+			return ErrInvalidSignature
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,71 @@
+package verifier
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NewIPAllowlist creates the Verifier allowing only the listed IP addresses or CIDR ranges, e.g. 10.0.0.1 or 10.0.0.0/24.
+func NewIPAllowlist(entries ...string) (v Verifier, err error) {
+	networks, err := ParseNetworks(entries...)
+	if err != nil {
+		return
+	}
+
+	v = VerifierFunc(func(r *Request) error {
+		if r.ClientIP == nil {
+			return ErrIPAddressNotAllowed
+		}
+
+		if containsIP(networks, r.ClientIP) {
+			return nil
+		}
+
+		return errors.Wrap(ErrIPAddressNotAllowed, r.ClientIP.String())
+	})
+	return
+}
+
+// ParseNetworks parses the IP addresses or CIDR ranges, e.g. 10.0.0.1 or 10.0.0.0/24, the IP address is parsed as a single host network.
+func ParseNetworks(entries ...string) (networks []*net.IPNet, err error) {
+	networks = make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, errors.Errorf("invalid IP address %q", entry)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, errParse := net.ParseCIDR(entry)
+		if errParse != nil {
+			return nil, errors.Wrapf(errParse, "invalid CIDR %q", entry)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
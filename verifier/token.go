@@ -0,0 +1,28 @@
+package verifier
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+// DefaultTokenHeader is the default header of the static token.
+const DefaultTokenHeader = "X-Wappin-Token"
+
+// NewTokenVerifier creates the Verifier checking the static token in the header.
+// The header is DefaultTokenHeader if it is empty.
+func NewTokenVerifier(header string, token string) Verifier {
+	if header == "" {
+		header = DefaultTokenHeader
+	}
+
+	// The tokens are hashed first so the comparison does not leak the length of the token.
+	expected := sha256.Sum256([]byte(token))
+	return VerifierFunc(func(r *Request) error {
+		got := sha256.Sum256([]byte(r.Header.Get(header)))
+		if token == "" || subtle.ConstantTimeCompare(got[:], expected[:]) != 1 {
+			return ErrInvalidToken
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,233 @@
+package verifier
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+)
+
+// List of errors returned by the verifiers.
+var (
+	ErrInvalidSignature    = errors.New("invalid webhook signature")
+	ErrInvalidToken        = errors.New("invalid webhook token")
+	ErrIPAddressNotAllowed = errors.New("IP address is not allowed")
+)
+
+// List of rejection codes, they are the same as the response codes of Wappin API.
+const (
+	CodeInvalidCredential   = "401"
+	CodeIPAddressNotAllowed = "407"
+)
+
+// Request is the incoming webhook request to be verified.
+// ClientIP is resolved from the RemoteAddr, or the Option.IPHeader if the RemoteAddr is a trusted proxy, before the verifiers are invoked.
+type Request struct {
+	Header     http.Header
+	RemoteAddr string
+	Body       []byte
+	ClientIP   net.IP
+}
+
+// NewHTTPRequest creates the Request from the net/http request and its body.
+func NewHTTPRequest(r *http.Request, body []byte) *Request {
+	return &Request{
+		Header:     r.Header,
+		RemoteAddr: r.RemoteAddr,
+		Body:       body,
+	}
+}
+
+// NewFiberRequest creates the Request from the Fiber context.
+func NewFiberRequest(c *fiber.Ctx) *Request {
+	header := make(http.Header)
+	c.Request().Header.VisitAll(func(key, value []byte) {
+		header.Add(string(key), string(value))
+	})
+
+	return &Request{
+		Header:     header,
+		RemoteAddr: c.Context().RemoteAddr().String(),
+		Body:       c.Body(),
+	}
+}
+
+// Verifier verifies the authenticity of the webhook request.
+type Verifier interface {
+	Verify(r *Request) error
+}
+
+// VerifierFunc is the function adapter of Verifier.
+type VerifierFunc func(r *Request) error
+
+// Verify implements the Verifier interface.
+func (f VerifierFunc) Verify(r *Request) error {
+	return f(r)
+}
+
+// RejectionError is returned when the webhook request is rejected by the verifiers.
+// Code is CodeIPAddressNotAllowed for the IP allowlist and CodeInvalidCredential otherwise,
+// StatusCode is the HTTP status code responded to the sender.
+type RejectionError struct {
+	Code       string
+	StatusCode int
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *RejectionError) Error() string {
+	return "webhook rejected with code " + e.Code + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error of the verifier.
+func (e *RejectionError) Unwrap() error {
+	return e.Err
+}
+
+func newRejectionError(err error) *RejectionError {
+	if errors.Is(err, ErrIPAddressNotAllowed) {
+		return &RejectionError{
+			Code:       CodeIPAddressNotAllowed,
+			StatusCode: http.StatusForbidden,
+			Err:        err,
+		}
+	}
+
+	return &RejectionError{
+		Code:       CodeInvalidCredential,
+		StatusCode: http.StatusUnauthorized,
+		Err:        err,
+	}
+}
+
+// RejectFunc is invoked for every rejected webhook request, e.g. for logging or metrics.
+type RejectFunc func(ctx context.Context, r *Request, err *RejectionError)
+
+// DefaultIPHeader is the default header containing the client IP appended by the trusted proxies.
+const DefaultIPHeader = "X-Forwarded-For"
+
+// Option is the verification option of the webhook handlers.
+// IPHeader is the header containing the client IP, it is DefaultIPHeader if it is empty
+// and only read if the RemoteAddr is in the TrustedProxies.
+type Option struct {
+	Verifiers      []Verifier
+	OnReject       RejectFunc
+	IPHeader       string
+	TrustedProxies []*net.IPNet
+}
+
+// FnOption is a functional option for the verification.
+type FnOption func(o *Option)
+
+// NewOption creates the Option from the functional options.
+func NewOption(opts ...FnOption) *Option {
+	o := new(Option)
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithVerifiers appends the verifiers of the webhook request, the request is accepted only if all verifiers pass.
+func WithVerifiers(verifiers ...Verifier) FnOption {
+	return func(o *Option) {
+		o.Verifiers = append(o.Verifiers, verifiers...)
+	}
+}
+
+// WithRejectHook sets the function invoked for every rejected webhook request.
+func WithRejectHook(fn RejectFunc) FnOption {
+	return func(o *Option) {
+		o.OnReject = fn
+	}
+}
+
+// WithIPHeader sets the header containing the client IP, it is only read from the TrustedProxies.
+func WithIPHeader(header string) FnOption {
+	return func(o *Option) {
+		o.IPHeader = header
+	}
+}
+
+// WithTrustedProxies appends the networks of the proxies trusted to set the IPHeader, parse them with ParseNetworks.
+func WithTrustedProxies(networks ...*net.IPNet) FnOption {
+	return func(o *Option) {
+		o.TrustedProxies = append(o.TrustedProxies, networks...)
+	}
+}
+
+// Verify runs all verifiers against the request and returns the first error as RejectionError.
+// Every verifier is invoked even if the previous one fails, so the rejection does not reveal which check failed by its timing.
+func (o *Option) Verify(ctx context.Context, r *Request) (err error) {
+	if len(o.Verifiers) == 0 {
+		return
+	}
+
+	r.ClientIP = o.clientIP(r)
+	for _, v := range o.Verifiers {
+		errVerify := v.Verify(r)
+		if err == nil && errVerify != nil {
+			err = errVerify
+		}
+	}
+
+	if err == nil {
+		return
+	}
+
+	rejectionErr := newRejectionError(err)
+	if o.OnReject != nil {
+		o.OnReject(ctx, r, rejectionErr)
+	}
+
+	err = rejectionErr
+	return
+}
+
+// clientIP returns the IP of the RemoteAddr, or the rightmost IP in the IPHeader that is not a trusted proxy
+// if the RemoteAddr is a trusted proxy. The leftmost entries of the header can be set by anyone, so they are never trusted.
+func (o *Option) clientIP(r *Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !containsIP(o.TrustedProxies, ip) {
+		return ip
+	}
+
+	header := o.IPHeader
+	if header == "" {
+		header = DefaultIPHeader
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values(header), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+
+		ip = net.ParseIP(hop)
+		if ip == nil || !containsIP(o.TrustedProxies, ip) {
+			return ip
+		}
+	}
+
+	return ip
+}
+
+// StatusCode returns the HTTP status code of the rejection, it returns 401 if the err is not RejectionError.
+func StatusCode(err error) int {
+	var rejectionErr *RejectionError
+	if errors.As(err, &rejectionErr) {
+		return rejectionErr.StatusCode
+	}
+
+	return http.StatusUnauthorized
+}
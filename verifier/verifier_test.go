@@ -0,0 +1,207 @@
+package verifier_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/flip-id/wappin/verifier"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	secret = []byte("shared-secret")
+	body   = []byte(`{"message_id":"message-id"}`)
+)
+
+func sign(b []byte) string {
+	return signWith(secret, b)
+}
+
+func signWith(key []byte, b []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerify(t *testing.T) {
+	allowlist, err := verifier.NewIPAllowlist("10.0.0.1", "192.168.1.0/24", "2001:db8::/32")
+	assert.Nil(t, err)
+
+	proxies, err := verifier.ParseNetworks("172.16.0.0/12")
+	assert.Nil(t, err)
+
+	tt := []struct {
+		name         string
+		opts         []verifier.FnOption
+		request      *verifier.Request
+		expectErr    error
+		expectCode   string
+		expectStatus int
+	}{
+		{
+			name:    "Accept without verifiers",
+			request: &verifier.Request{Header: http.Header{}, Body: body},
+		},
+		{
+			name: "Accept valid HMAC signature",
+			opts: []verifier.FnOption{verifier.WithVerifiers(verifier.NewHMACVerifier("", secret))},
+			request: &verifier.Request{
+				Header: http.Header{verifier.DefaultSignatureHeader: []string{"sha256=" + sign(body)}},
+				Body:   body,
+			},
+		},
+		{
+			name: "Reject invalid HMAC signature",
+			opts: []verifier.FnOption{verifier.WithVerifiers(verifier.NewHMACVerifier("", secret))},
+			request: &verifier.Request{
+				Header: http.Header{verifier.DefaultSignatureHeader: []string{sign([]byte("tampered"))}},
+				Body:   body,
+			},
+			expectErr:    verifier.ErrInvalidSignature,
+			expectCode:   verifier.CodeInvalidCredential,
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Reject missing HMAC signature",
+			opts: []verifier.FnOption{verifier.WithVerifiers(verifier.NewHMACVerifier("X-Signature", secret))},
+			request: &verifier.Request{
+				Header: http.Header{},
+				Body:   body,
+			},
+			expectErr:    verifier.ErrInvalidSignature,
+			expectCode:   verifier.CodeInvalidCredential,
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Reject HMAC signature with empty secret",
+			opts: []verifier.FnOption{verifier.WithVerifiers(verifier.NewHMACVerifier("", nil))},
+			request: &verifier.Request{
+				Header: http.Header{verifier.DefaultSignatureHeader: []string{signWith(nil, body)}},
+				Body:   body,
+			},
+			expectErr:    verifier.ErrInvalidSignature,
+			expectCode:   verifier.CodeInvalidCredential,
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Accept valid token",
+			opts: []verifier.FnOption{verifier.WithVerifiers(verifier.NewTokenVerifier("", "token"))},
+			request: &verifier.Request{
+				Header: http.Header{verifier.DefaultTokenHeader: []string{"token"}},
+			},
+		},
+		{
+			name: "Reject invalid token",
+			opts: []verifier.FnOption{verifier.WithVerifiers(verifier.NewTokenVerifier("", "token"))},
+			request: &verifier.Request{
+				Header: http.Header{verifier.DefaultTokenHeader: []string{"tokenn"}},
+			},
+			expectErr:    verifier.ErrInvalidToken,
+			expectCode:   verifier.CodeInvalidCredential,
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name:    "Accept allowed IP address from the remote address",
+			opts:    []verifier.FnOption{verifier.WithVerifiers(allowlist)},
+			request: &verifier.Request{Header: http.Header{}, RemoteAddr: "192.168.1.20:51234"},
+		},
+		{
+			name:    "Accept allowed IPv6 address",
+			opts:    []verifier.FnOption{verifier.WithVerifiers(allowlist)},
+			request: &verifier.Request{Header: http.Header{}, RemoteAddr: "[2001:db8::1]:443"},
+		},
+		{
+			name: "Accept allowed IP address from the IP header of the trusted proxies",
+			opts: []verifier.FnOption{verifier.WithVerifiers(allowlist), verifier.WithTrustedProxies(proxies...)},
+			request: &verifier.Request{
+				Header:     http.Header{"X-Forwarded-For": []string{"203.0.113.5, 10.0.0.1", "172.16.0.2"}},
+				RemoteAddr: "172.16.0.1:51234",
+			},
+		},
+		{
+			name: "Reject spoofed IP header from the untrusted peer",
+			opts: []verifier.FnOption{verifier.WithVerifiers(allowlist), verifier.WithIPHeader("X-Forwarded-For")},
+			request: &verifier.Request{
+				Header:     http.Header{"X-Forwarded-For": []string{"10.0.0.1"}},
+				RemoteAddr: "203.0.113.5:51234",
+			},
+			expectErr:    verifier.ErrIPAddressNotAllowed,
+			expectCode:   verifier.CodeIPAddressNotAllowed,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Reject spoofed leftmost IP header behind the trusted proxies",
+			opts: []verifier.FnOption{verifier.WithVerifiers(allowlist), verifier.WithTrustedProxies(proxies...)},
+			request: &verifier.Request{
+				Header:     http.Header{"X-Forwarded-For": []string{"10.0.0.1, 203.0.113.5"}},
+				RemoteAddr: "172.16.0.1:51234",
+			},
+			expectErr:    verifier.ErrIPAddressNotAllowed,
+			expectCode:   verifier.CodeIPAddressNotAllowed,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name:         "Reject not allowed IP address",
+			opts:         []verifier.FnOption{verifier.WithVerifiers(allowlist)},
+			request:      &verifier.Request{Header: http.Header{}, RemoteAddr: "10.0.0.2:51234"},
+			expectErr:    verifier.ErrIPAddressNotAllowed,
+			expectCode:   verifier.CodeIPAddressNotAllowed,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Reject with the first failed verifier",
+			opts: []verifier.FnOption{verifier.WithVerifiers(
+				verifier.NewTokenVerifier("", "token"),
+				allowlist,
+			)},
+			request:      &verifier.Request{Header: http.Header{}, RemoteAddr: "10.0.0.2:51234"},
+			expectErr:    verifier.ErrInvalidToken,
+			expectCode:   verifier.CodeInvalidCredential,
+			expectStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var rejected *verifier.RejectionError
+			opts := append(tc.opts, verifier.WithRejectHook(func(ctx context.Context, r *verifier.Request, err *verifier.RejectionError) {
+				rejected = err
+			}))
+
+			err := verifier.NewOption(opts...).Verify(context.Background(), tc.request)
+			if tc.expectErr == nil {
+				assert.Nil(t, err)
+				assert.Nil(t, rejected)
+				return
+			}
+
+			assert.True(t, errors.Is(err, tc.expectErr))
+			assert.Equal(t, err, rejected)
+			assert.Equal(t, tc.expectCode, rejected.Code)
+			assert.Equal(t, tc.expectStatus, verifier.StatusCode(err))
+		})
+	}
+}
+
+func TestNewIPAllowlist(t *testing.T) {
+	_, err := verifier.NewIPAllowlist("10.0.0.300")
+	assert.NotNil(t, err)
+
+	_, err = verifier.NewIPAllowlist("10.0.0.0/33")
+	assert.NotNil(t, err)
+}
+
+func TestParseNetworks(t *testing.T) {
+	networks, err := verifier.ParseNetworks("10.0.0.1", "172.16.0.0/12")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.0.0.1/32", "172.16.0.0/12"}, []string{networks[0].String(), networks[1].String()})
+
+	networks, err = verifier.ParseNetworks("proxy")
+	assert.NotNil(t, err)
+	assert.Nil(t, networks)
+}
@@ -1,15 +1,16 @@
 package wappin
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/fairyhunter13/pool"
 	"github.com/flip-id/valuefirst/manager"
-	"github.com/flip-id/valuefirst/storage"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -19,6 +20,8 @@ type Client interface {
 	// TokenClient implements all TokenClient interface from the valuefirst package.
 	manager.TokenClient
 	SendMessage(ctx context.Context, reqMsg *RequestWhatsappMessage) (res *ResponseMessage, err error)
+	GetMessageStatus(ctx context.Context, messageID string) (res *ResponseMessageStatus, err error)
+	Do(ctx context.Context, method string, path string, body interface{}, out interface{}) (err error)
 }
 
 type client struct {
@@ -50,37 +53,35 @@ func (c *client) SendMessage(ctx context.Context, reqMsg *RequestWhatsappMessage
 		return
 	}
 
-	res, err = c.postToWappin(ctx, EndpointSendHSM, reqMsg.Default(c.opt))
-	if err == nil {
+	return c.postToWappin(ctx, EndpointSendHSM, reqMsg.Default(c.opt))
+}
+
+// GetMessageStatus gets the delivery status of the message by the message ID returned from SendMessage.
+// The token is regenerated once on 401, and the non-JSON error body, e.g. the HTML of a proxy, is returned as *Error.
+func (c *client) GetMessageStatus(ctx context.Context, messageID string) (res *ResponseMessageStatus, err error) {
+	if messageID == "" {
+		err = ErrNilArguments
 		return
 	}
 
-	wappinErr, ok := err.(*Error)
-	if !ok {
+	reqStatus := (&RequestMessageStatus{MessageID: messageID}).Default(c.opt)
+	statusCode, byteBody, err := c.requestToWappin(ctx, http.MethodPost, EndpointMessageStatus, reqStatus)
+	if err != nil {
 		return
 	}
 
-	// create new token if we get invalid credential
-	if wappinErr.Status == "401" {
-		var tokenResp manager.ResponseGenerateToken
-		var respToken *storage.Token
-
-		tokenResp, err = c.GenerateToken(ctx)
-		if err != nil {
-			return
-		}
-
-		respToken, err = tokenResp.ToToken()
-		if err != nil {
-			return
+	err = json.Unmarshal(byteBody, &res)
+	if err != nil {
+		if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+			err = newStatusError(statusCode, byteBody)
 		}
 
-		err = c.opt.Storage.Save(ctx, c.opt.TokenCacheKey, respToken.SetHalfExpiredDate(time.Now()))
-
-		// re-hit send message to Wappin
-		return c.postToWappin(ctx, EndpointSendHSM, reqMsg.Default(c.opt))
+		return nil, err
 	}
 
+	res.HttpStatusCode = statusCode
+	res.RawData = convertByteToString(byteBody)
+	err = getError(res.HttpStatusCode, res.Status, res.Message)
 	return
 }
 
@@ -90,16 +91,113 @@ func (c *client) prepareRequest(ctx context.Context, req *http.Request) *http.Re
 }
 
 func (c *client) postToWappin(ctx context.Context, endpoint string, body interface{}) (res *ResponseMessage, err error) {
+	statusCode, byteBody, err := c.requestToWappin(ctx, http.MethodPost, endpoint, body)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(byteBody, &res)
+	if err != nil {
+		return
+	}
+
+	res.HttpStatusCode = statusCode
+	res.RawData = convertByteToString(byteBody)
+	err = getError(res.HttpStatusCode, res.Status, res.Message)
+	return
+}
+
+// Do sends a request to the path of Wappin API using the token from the token manager, the token is regenerated once on 401.
+// The body is encoded as JSON if it is not nil and the JSON response is decoded to the out if it is not nil and Wappin responds with 2xx.
+// It returns *Error if Wappin responds with an error status, the non-JSON error body is truncated to the message of the Error.
+func (c *client) Do(ctx context.Context, method string, path string, body interface{}, out interface{}) (err error) {
+	statusCode, byteBody, err := c.requestToWappin(ctx, method, path, body)
+	if err != nil {
+		return
+	}
+
+	var res ResponseMessage
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		if json.Unmarshal(byteBody, &res) == nil && res.Status != "" {
+			return CastError(res.Status, res.Message)
+		}
+
+		return newStatusError(statusCode, byteBody)
+	}
+
+	if len(byteBody) == 0 {
+		return
+	}
+
+	err = json.Unmarshal(byteBody, &res)
+	if err != nil {
+		return
+	}
+
+	err = getError(statusCode, res.Status, res.Message)
+	if err != nil || out == nil {
+		return
+	}
+
+	err = json.Unmarshal(byteBody, out)
+	return
+}
+
+// requestToWappin does the request to Wappin using the token from the token manager.
+// If Wappin responds with 401, either in the status code or in the status of the body, the token is regenerated and the request is retried once.
+func (c *client) requestToWappin(ctx context.Context, method string, endpoint string, body interface{}) (statusCode int, byteBody []byte, err error) {
 	buff := pool.GetBuffer()
 	defer pool.Put(buff)
 
-	err = json.NewEncoder(buff).Encode(body)
+	if body != nil {
+		err = json.NewEncoder(buff).Encode(body)
+		if err != nil {
+			return
+		}
+	}
+
+	payload := buff.Bytes()
+	statusCode, byteBody, err = c.sendToWappin(ctx, method, endpoint, payload)
+	if err != nil || !isUnauthorized(statusCode, byteBody) {
+		return
+	}
+
+	err = c.regenerateToken(ctx)
+	if err != nil {
+		return
+	}
+
+	return c.sendToWappin(ctx, method, endpoint, payload)
+}
+
+func isUnauthorized(statusCode int, byteBody []byte) bool {
+	if statusCode == http.StatusUnauthorized {
+		return true
+	}
+
+	var res ResponseMessage
+	return json.Unmarshal(byteBody, &res) == nil && res.Status == strconv.Itoa(http.StatusUnauthorized)
+}
+
+// regenerateToken creates a new token and saves it to the storage of the token manager.
+func (c *client) regenerateToken(ctx context.Context) (err error) {
+	tokenResp, err := c.GenerateToken(ctx)
+	if err != nil {
+		return
+	}
+
+	respToken, err := tokenResp.ToToken()
 	if err != nil {
 		return
 	}
 
+	err = c.opt.Storage.Save(ctx, c.opt.TokenCacheKey, respToken.SetHalfExpiredDate(time.Now()))
+	return
+}
+
+func (c *client) sendToWappin(ctx context.Context, method string, endpoint string, payload []byte) (statusCode int, byteBody []byte, err error) {
 	url := c.opt.BaseURL + endpoint
-	req, err := http.NewRequest(http.MethodPost, url, buff)
+	req, err := http.NewRequest(method, url, bytes.NewReader(payload))
 	if err != nil {
 		return
 	}
@@ -120,19 +218,12 @@ func (c *client) postToWappin(ctx context.Context, endpoint string, body interfa
 		}
 	}()
 
-	byteBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return
-	}
-
-	err = json.Unmarshal(byteBody, &res)
+	byteBody, err = io.ReadAll(resp.Body)
 	if err != nil {
 		return
 	}
 
-	res.HttpStatusCode = resp.StatusCode
-	res.RawData = convertByteToString(byteBody)
-	err = getError(res.HttpStatusCode, res.Status, res.Message)
+	statusCode = resp.StatusCode
 	return
 }
 
@@ -30,6 +30,7 @@ type (
 		SendMessageCalledTimes int
 		DoGenerateTokenFunc    func(*http.Request) (*response, error)
 		DoSendMessageFunc      func(*http.Request) (*response, error)
+		DoFunc                 func(*http.Request) (*response, error)
 	}
 )
 
@@ -65,6 +66,18 @@ func (d *doerMock) Do(req *http.Request) (*http.Response, error) {
 		}
 	}
 
+	if url != "https://api.wappin.id/v1/token/get" && url != "https://api.wappin.id/v1/message/do-send-hsm" {
+		if d.DoFunc != nil {
+			r, err := d.DoFunc(req)
+			if err != nil {
+				return nil, err
+			}
+
+			status = r.status
+			jsonResponse = r.jsonResponse
+		}
+	}
+
 	return &http.Response{
 		Status:     fmt.Sprintf("%d", status),
 		StatusCode: status,
@@ -376,3 +389,273 @@ func (ts *wappinTestSuite) TestSendMessage() {
 	}
 
 }
+
+func (ts *wappinTestSuite) TestDo() {
+	tt := []struct {
+		name      string
+		mock      func()
+		expect    func() (map[string]interface{}, error)
+		expectErr bool
+	}{
+		{
+			name: "success do",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoGenerateTokenFunc: func(r *http.Request) (*response, error) {
+						return &response{status: http.StatusOK, jsonResponse: defaultJsonResponse}, nil
+					},
+					DoFunc: func(r *http.Request) (*response, error) {
+						if r.Method != http.MethodGet || r.URL.String() != "https://api.wappin.id/v1/new-endpoint" ||
+							r.Header.Get("Authorization") != "Bearer access-token" {
+							return nil, errors.New("unexpected request")
+						}
+
+						return &response{
+							status:       http.StatusOK,
+							jsonResponse: `{"status":"200","message":"Success","data":{"quota":10}}`,
+						}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return map[string]interface{}{
+					"status":  "200",
+					"message": "Success",
+					"data":    map[string]interface{}{"quota": float64(10)},
+				}, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "error do - wappin error",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoGenerateTokenFunc: func(r *http.Request) (*response, error) {
+						return &response{status: http.StatusOK, jsonResponse: defaultJsonResponse}, nil
+					},
+					DoFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       http.StatusNotFound,
+							jsonResponse: `{"status":"404","message":"endpoint not found"}`,
+						}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return nil, &wappin.Error{
+					Status:  "404",
+					Message: "endpoint not found",
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "success do - regenerate token on 401",
+			mock: func() {
+				var generated int
+				ts.doer = &doerMock{
+					DoGenerateTokenFunc: func(r *http.Request) (*response, error) {
+						generated++
+						if generated == 1 {
+							return &response{status: http.StatusOK, jsonResponse: defaultJsonResponse}, nil
+						}
+
+						return &response{
+							status:       http.StatusOK,
+							jsonResponse: strings.Replace(defaultJsonResponse, "access-token", "new-access-token", 1),
+						}, nil
+					},
+					DoFunc: func(r *http.Request) (*response, error) {
+						if r.Header.Get("Authorization") != "Bearer new-access-token" {
+							return &response{status: http.StatusUnauthorized}, nil
+						}
+
+						return &response{
+							status:       http.StatusOK,
+							jsonResponse: `{"status":"200","message":"Success"}`,
+						}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return map[string]interface{}{
+					"status":  "200",
+					"message": "Success",
+				}, nil
+			},
+			expectErr: false,
+		},
+		{
+			name: "error do - non-JSON server error",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoGenerateTokenFunc: func(r *http.Request) (*response, error) {
+						return &response{status: http.StatusOK, jsonResponse: defaultJsonResponse}, nil
+					},
+					DoFunc: func(r *http.Request) (*response, error) {
+						return &response{
+							status:       http.StatusBadGateway,
+							jsonResponse: "<html><body>" + strings.Repeat("Bad Gateway ", 50) + "</body></html>",
+						}, nil
+					},
+				}
+			},
+			expect: func() (map[string]interface{}, error) {
+				return nil, wappin.CastError("502", "<html><body>"+strings.Repeat("Bad Gateway ", 50)[:500]+"...")
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		ts.T().Run(tc.name, func(t *testing.T) {
+			tc.mock()
+			ts.wp = wappin.New(
+				wappin.WithHystrixOptions(hystrix.WithErrorPercentThreshold(100)),
+				wappin.WithClient(ts.doer),
+			)
+
+			expectOut, expectErr := tc.expect()
+
+			var out map[string]interface{}
+			err := ts.wp.Do(context.Background(), http.MethodGet, "/v1/new-endpoint", nil, &out)
+			if tc.expectErr {
+				assert.Equal(t, expectErr.Error(), err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, expectOut, out)
+		})
+	}
+}
+
+func (ts *wappinTestSuite) TestGetMessageStatus() {
+	ts.doer = &doerMock{
+		DoGenerateTokenFunc: func(r *http.Request) (*response, error) {
+			return &response{status: http.StatusOK, jsonResponse: defaultJsonResponse}, nil
+		},
+		DoFunc: func(r *http.Request) (*response, error) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			if r.URL.String() != "https://api.wappin.id/v1/message/status" ||
+				strings.TrimSpace(string(body)) != `{"client_id":"client-id","project_id":"project-id","message_id":"message-id"}` {
+				return nil, errors.New("unexpected request")
+			}
+
+			return &response{
+				status:       http.StatusOK,
+				jsonResponse: `{"status":"200","message":"Success","data":{"message_id":"message-id","status_messages":"Delivered","timestamp":"2023-08-03 10:45:36"}}`,
+			}, nil
+		},
+	}
+	ts.wp = wappin.New(
+		wappin.WithHystrixOptions(hystrix.WithErrorPercentThreshold(100)),
+		wappin.WithClient(ts.doer),
+		wappin.WithClientID("client-id"),
+		wappin.WithProjectID("project-id"),
+	)
+
+	res, err := ts.wp.GetMessageStatus(context.Background(), "message-id")
+
+	ts.Nil(err)
+	ts.Equal("message-id", res.Data.MessageID)
+	ts.Equal(http.StatusOK, res.HttpStatusCode)
+	ts.Equal(wappin.MessageStatusDelivered, res.MessageStatus())
+}
+
+func (ts *wappinTestSuite) TestGetMessageStatusErrorResponses() {
+	statusJson := `{"status":"200","message":"Success","data":{"message_id":"message-id","status_messages":"Read","timestamp":"2023-08-03 10:45:36"}}`
+
+	tt := []struct {
+		name      string
+		mock      func()
+		expectErr error
+	}{
+		{
+			name: "success after regenerating the revoked token",
+			mock: func() {
+				var generated int
+				ts.doer = &doerMock{
+					DoGenerateTokenFunc: func(r *http.Request) (*response, error) {
+						generated++
+						if generated == 1 {
+							return &response{status: http.StatusOK, jsonResponse: defaultJsonResponse}, nil
+						}
+
+						return &response{
+							status:       http.StatusOK,
+							jsonResponse: strings.Replace(defaultJsonResponse, "access-token", "new-access-token", 1),
+						}, nil
+					},
+					DoFunc: func(r *http.Request) (*response, error) {
+						if r.Header.Get("Authorization") != "Bearer new-access-token" {
+							return &response{
+								status:       http.StatusUnauthorized,
+								jsonResponse: `{"status":"401","message":"error invalid token"}`,
+							}, nil
+						}
+
+						return &response{status: http.StatusOK, jsonResponse: statusJson}, nil
+					},
+				}
+			},
+		},
+		{
+			name: "error non-JSON server error",
+			mock: func() {
+				ts.doer = &doerMock{
+					DoGenerateTokenFunc: func(r *http.Request) (*response, error) {
+						return &response{status: http.StatusOK, jsonResponse: defaultJsonResponse}, nil
+					},
+					DoFunc: func(r *http.Request) (*response, error) {
+						return &response{status: http.StatusServiceUnavailable, jsonResponse: "<html>Service Unavailable</html>"}, nil
+					},
+				}
+			},
+			expectErr: wappin.CastError("503", "<html>Service Unavailable</html>"),
+		},
+	}
+
+	for _, tc := range tt {
+		ts.T().Run(tc.name, func(t *testing.T) {
+			tc.mock()
+			ts.wp = wappin.New(
+				wappin.WithHystrixOptions(hystrix.WithErrorPercentThreshold(100)),
+				wappin.WithClient(ts.doer),
+			)
+
+			res, err := ts.wp.GetMessageStatus(context.Background(), "message-id")
+			if tc.expectErr != nil {
+				assert.Equal(t, tc.expectErr.Error(), err.Error())
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, wappin.MessageStatusRead, res.MessageStatus())
+		})
+	}
+}
+
+func TestParseMessageStatus(t *testing.T) {
+	tt := []struct {
+		status string
+		expect wappin.MessageStatus
+	}{
+		{status: "sent", expect: wappin.MessageStatusSent},
+		{status: "Delivered", expect: wappin.MessageStatusDelivered},
+		{status: " READ ", expect: wappin.MessageStatusRead},
+		{status: "failed", expect: wappin.MessageStatusFailed},
+		{status: "deleted", expect: wappin.MessageStatusUnknown},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.status, func(t *testing.T) {
+			assert.Equal(t, tc.expect, wappin.ParseMessageStatus(tc.status))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flip-id/wappin/storage"
+	"github.com/google/martian/log"
+)
+
+// List of default values of the dedupe option.
+const (
+	DefaultDedupeKeyPrefix = "wappin:webhook:dedupe:"
+	DefaultDedupeTTL       = 24 * time.Hour
+)
+
+// DedupeOption is the option of the Dedupe middleware.
+type DedupeOption struct {
+	Storage   storage.IDedupeStorage
+	KeyPrefix string
+	TTL       time.Duration
+}
+
+// FnDedupeOption is a functional option for the Dedupe middleware.
+type FnDedupeOption func(o *DedupeOption)
+
+// WithDedupeStorage sets the storage of the seen events, the default is the in-memory storage.
+// Use the Redis storage to dedupe the events across the instances of the service.
+func WithDedupeStorage(s storage.IDedupeStorage) FnDedupeOption {
+	return func(o *DedupeOption) {
+		o.Storage = s
+	}
+}
+
+// WithDedupeKeyPrefix sets the key prefix of the seen events in the storage.
+func WithDedupeKeyPrefix(prefix string) FnDedupeOption {
+	return func(o *DedupeOption) {
+		o.KeyPrefix = prefix
+	}
+}
+
+// WithDedupeTTL sets how long the seen events are kept, the duplicate event after the TTL is dispatched again.
+func WithDedupeTTL(ttl time.Duration) FnDedupeOption {
+	return func(o *DedupeOption) {
+		o.TTL = ttl
+	}
+}
+
+// Assign assigns the option to the middleware.
+func (o *DedupeOption) Assign(opts ...FnDedupeOption) *DedupeOption {
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// Default returns the default option.
+func (o *DedupeOption) Default() *DedupeOption {
+	if o.Storage == nil {
+		o.Storage = storage.NewMemory()
+	}
+
+	if o.KeyPrefix == "" {
+		o.KeyPrefix = DefaultDedupeKeyPrefix
+	}
+
+	if o.TTL <= 0 {
+		o.TTL = DefaultDedupeTTL
+	}
+
+	return o
+}
+
+// Dedupe returns the middleware skipping the event already handled within the TTL, e.g. the callback retried by Wappin.
+// The event is keyed by the HandlerID, the message id, the status and the timestamp, so every handler is deduped on its own.
+// The key is claimed before the handler is invoked, so the same event arriving concurrently is handled once,
+// and it is released if the handler fails, so the failed event is dispatched again on the retry.
+// The event is dispatched anyway if the storage is unavailable.
+func Dedupe(opts ...FnDedupeOption) Middleware {
+	o := new(DedupeOption).Assign(opts...).Default()
+
+	return func(next Handler) Handler {
+		return func(ctx context.Context, e *Event) (err error) {
+			if e.MessageID == "" {
+				return next(ctx, e)
+			}
+
+			key := o.key(ctx, e)
+			claimed, err := o.Storage.SaveIfAbsent(ctx, key, true, o.TTL)
+			if err != nil {
+				log.Errorf("Error claiming webhook event with key = %s and error = %v", key, err)
+				return next(ctx, e)
+			}
+
+			if !claimed {
+				return
+			}
+
+			err = next(ctx, e)
+			if err == nil {
+				return
+			}
+
+			errDelete := o.Storage.Delete(ctx, key)
+			if errDelete != nil {
+				log.Errorf("Error releasing webhook event with key = %s and error = %v", key, errDelete)
+			}
+
+			return
+		}
+	}
+}
+
+func (o *DedupeOption) key(ctx context.Context, e *Event) string {
+	var timestamp string
+	if !e.Time.IsZero() {
+		timestamp = strconv.FormatInt(e.Time.Unix(), 10)
+	}
+
+	return o.KeyPrefix + strings.Join([]string{HandlerID(ctx), e.MessageID, string(e.Status), timestamp}, ":")
+}
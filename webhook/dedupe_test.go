@@ -0,0 +1,168 @@
+package webhook_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/flip-id/wappin"
+	"github.com/flip-id/wappin/storage"
+	v2 "github.com/flip-id/wappin/v2"
+	"github.com/flip-id/wappin/webhook"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type brokenStorage struct{}
+
+func (brokenStorage) Get(ctx context.Context, key string) (interface{}, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (brokenStorage) Save(ctx context.Context, key string, i interface{}, ttl time.Duration) error {
+	return errors.New("connection refused")
+}
+
+func (brokenStorage) SaveIfAbsent(ctx context.Context, key string, i interface{}, ttl time.Duration) (bool, error) {
+	return false, errors.New("connection refused")
+}
+
+func (brokenStorage) Delete(ctx context.Context, key string) error {
+	return errors.New("connection refused")
+}
+
+func newStatusWebhook(status string, timestamp string) *v2.Webhook {
+	return &v2.Webhook{
+		Statuses: []v2.StatusWebhook{
+			{Id: "status-id", RecipientId: "6281111111", Status: status, Timestamp: timestamp},
+		},
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Skip duplicate events", func(t *testing.T) {
+		var calls []wappin.MessageStatus
+		d := webhook.NewDispatcher().
+			Use(webhook.Dedupe()).
+			OnStatus(func(ctx context.Context, e *webhook.Event) error {
+				calls = append(calls, e.Status)
+				return nil
+			}).
+			OnStatus(func(ctx context.Context, e *webhook.Event) error {
+				calls = append(calls, e.Status)
+				return nil
+			})
+
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusRead, "1691034340")))
+
+		assert.Equal(t, []wappin.MessageStatus{
+			wappin.MessageStatusDelivered,
+			wappin.MessageStatusDelivered,
+			wappin.MessageStatusRead,
+			wappin.MessageStatusRead,
+		}, calls)
+	})
+
+	t.Run("Dispatch again after the handler fails", func(t *testing.T) {
+		var calls int
+		d := webhook.NewDispatcher().
+			Use(webhook.Dedupe(webhook.WithDedupeStorage(storage.NewMemory()))).
+			OnStatus(func(ctx context.Context, e *webhook.Event) error {
+				calls++
+				if calls == 1 {
+					return errHandler
+				}
+
+				return nil
+			})
+
+		err := d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336"))
+		assert.True(t, errors.Is(err, errHandler))
+
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Skip duplicate events dispatched concurrently", func(t *testing.T) {
+		var calls int32
+		d := webhook.NewDispatcher().
+			Use(webhook.Dedupe()).
+			OnStatus(func(ctx context.Context, e *webhook.Event) error {
+				atomic.AddInt32(&calls, 1)
+				return nil
+			})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("Dispatch again only to the failed handler", func(t *testing.T) {
+		var succeeded, failed int
+		d := webhook.NewDispatcher().
+			Use(webhook.Dedupe()).
+			OnStatus(func(ctx context.Context, e *webhook.Event) error {
+				succeeded++
+				return nil
+			}).
+			OnStatus(func(ctx context.Context, e *webhook.Event) error {
+				failed++
+				if failed == 1 {
+					return errHandler
+				}
+
+				return nil
+			})
+
+		err := d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336"))
+		assert.True(t, errors.Is(err, errHandler))
+
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		assert.Equal(t, 1, succeeded)
+		assert.Equal(t, 2, failed)
+	})
+
+	t.Run("Dispatch again after the TTL", func(t *testing.T) {
+		var calls int
+		d := webhook.NewDispatcher().
+			Use(webhook.Dedupe(webhook.WithDedupeTTL(time.Millisecond))).
+			OnStatus(func(ctx context.Context, e *webhook.Event) error {
+				calls++
+				return nil
+			})
+
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		time.Sleep(5 * time.Millisecond)
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Dispatch when the storage is unavailable", func(t *testing.T) {
+		var calls int
+		d := webhook.NewDispatcher().
+			Use(webhook.Dedupe(webhook.WithDedupeStorage(brokenStorage{}))).
+			OnStatus(func(ctx context.Context, e *webhook.Event) error {
+				calls++
+				return nil
+			})
+
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		assert.Nil(t, d.DispatchWebhook(ctx, newStatusWebhook(v2.MessageStatusDelivered, "1691034336")))
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/flip-id/wappin"
+	v2 "github.com/flip-id/wappin/v2"
+	"github.com/pkg/errors"
+)
+
+// Handler is the function invoked for the dispatched event.
+type Handler func(ctx context.Context, e *Event) error
+
+// Middleware wraps the Handler, e.g. for logging, tracing or recovering panics.
+type Middleware func(next Handler) Handler
+
+// EventError is the error returned by the handler of the event.
+type EventError struct {
+	Event *Event
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *EventError) Error() string {
+	if e.Event == nil {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s event of message %s: %v", e.Event.Type, e.Event.MessageID, e.Err)
+}
+
+// Unwrap returns the underlying error of the event.
+func (e *EventError) Unwrap() error {
+	return e.Err
+}
+
+// DispatchError is returned when one or more events are failed, it lists the error of every failed event.
+type DispatchError struct {
+	Errors []*EventError
+}
+
+// Error implements the error interface.
+func (e *DispatchError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, eventErr := range e.Errors {
+		messages[i] = eventErr.Error()
+	}
+
+	return "failed to dispatch events: " + strings.Join(messages, "; ")
+}
+
+// Is reports whether any of the event errors matches the target.
+func (e *DispatchError) Is(target error) bool {
+	for _, eventErr := range e.Errors {
+		if errors.Is(eventErr.Err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *DispatchError) add(event *Event, err error) {
+	if err == nil {
+		return
+	}
+
+	var dispatchErr *DispatchError
+	if errors.As(err, &dispatchErr) {
+		e.Errors = append(e.Errors, dispatchErr.Errors...)
+		return
+	}
+
+	e.Errors = append(e.Errors, &EventError{
+		Event: event,
+		Err:   err,
+	})
+}
+
+func (e *DispatchError) err() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Dispatcher routes the events of the v1 callback and the v2 webhook to the registered handlers by the EventType.
+// Register the handlers and the middlewares before dispatching any event, the Dispatcher is safe for concurrent dispatching.
+type Dispatcher struct {
+	handlers    map[EventType][]Handler
+	middlewares []Middleware
+}
+
+// NewDispatcher creates a new Dispatcher without any handler.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		handlers: make(map[EventType][]Handler),
+	}
+}
+
+// Use appends the middlewares, the first middleware is the outermost one.
+func (d *Dispatcher) Use(middlewares ...Middleware) *Dispatcher {
+	d.middlewares = append(d.middlewares, middlewares...)
+	return d
+}
+
+// On registers the handler for the EventType, the handlers of the same EventType are invoked in the registration order.
+func (d *Dispatcher) On(eventType EventType, h Handler) *Dispatcher {
+	d.handlers[eventType] = append(d.handlers[eventType], h)
+	return d
+}
+
+// OnStatus registers the handler for the status of the sent message.
+func (d *Dispatcher) OnStatus(h Handler) *Dispatcher {
+	return d.On(EventTypeStatus, h)
+}
+
+// OnText registers the handler for the inbound text message.
+func (d *Dispatcher) OnText(h Handler) *Dispatcher {
+	return d.On(EventTypeText, h)
+}
+
+// OnImage registers the handler for the inbound image message.
+func (d *Dispatcher) OnImage(h Handler) *Dispatcher {
+	return d.On(EventTypeImage, h)
+}
+
+// OnButtonReply registers the handler for the reply button and the quick reply button clicked by the user.
+func (d *Dispatcher) OnButtonReply(h Handler) *Dispatcher {
+	return d.On(EventTypeButtonReply, h)
+}
+
+// OnListReply registers the handler for the list row chosen by the user.
+func (d *Dispatcher) OnListReply(h Handler) *Dispatcher {
+	return d.On(EventTypeListReply, h)
+}
+
+// OnUnknown registers the handler for the unknown event and the event without any registered handler.
+func (d *Dispatcher) OnUnknown(h Handler) *Dispatcher {
+	return d.On(EventTypeUnknown, h)
+}
+
+// Dispatch invokes the handlers of the event wrapped by the middlewares.
+// Every handler is invoked even if the previous one fails, the errors are returned as DispatchError.
+// The ctx passed to the middlewares carries the HandlerID of the invoked handler.
+func (d *Dispatcher) Dispatch(ctx context.Context, e *Event) (err error) {
+	if e == nil {
+		err = wappin.ErrNilArguments
+		return
+	}
+
+	eventType := e.Type
+	handlers, ok := d.handlers[eventType]
+	if !ok {
+		eventType = EventTypeUnknown
+		handlers = d.handlers[eventType]
+	}
+
+	if len(handlers) == 0 {
+		return
+	}
+
+	errs := new(DispatchError)
+	for i, h := range handlers {
+		handlerCtx := context.WithValue(ctx, handlerIDKey{}, fmt.Sprintf("%s:%d", eventType, i))
+		errs.add(e, d.wrap(h)(handlerCtx, e))
+	}
+
+	err = errs.err()
+	return
+}
+
+type handlerIDKey struct{}
+
+// HandlerID returns the id of the handler invoked by Dispatch, it is the EventType of the handler and its registration index,
+// e.g. status:0 for the first status handler. It is empty if the ctx is not from Dispatch.
+func HandlerID(ctx context.Context) string {
+	id, _ := ctx.Value(handlerIDKey{}).(string)
+	return id
+}
+
+// DispatchCallback dispatches the v1 callback, it can be used as wappin.CallbackFunc.
+func (d *Dispatcher) DispatchCallback(ctx context.Context, cb *wappin.Callback) (err error) {
+	if cb == nil {
+		err = wappin.ErrNilArguments
+		return
+	}
+
+	return d.Dispatch(ctx, NewCallbackEvent(cb))
+}
+
+// DispatchWebhook dispatches every status and message of the v2 webhook, it can be used as v2.WebhookFunc.
+// The statuses are dispatched before the messages and the errors of all events are aggregated as DispatchError.
+// The webhook with an invalid timestamp is not dispatched at all, v2.ParseWebhook already rejects it with v2.ErrInvalidWebhook.
+func (d *Dispatcher) DispatchWebhook(ctx context.Context, wh *v2.Webhook) (err error) {
+	if wh == nil {
+		err = v2.ErrNilArguments
+		return
+	}
+
+	events, err := NewWebhookEvents(wh)
+	if err != nil {
+		return
+	}
+
+	errs := new(DispatchError)
+	for _, e := range events {
+		errs.add(e, d.Dispatch(ctx, e))
+	}
+
+	err = errs.err()
+	return
+}
+
+func (d *Dispatcher) wrap(h Handler) Handler {
+	for i := len(d.middlewares) - 1; i >= 0; i-- {
+		h = d.middlewares[i](h)
+	}
+
+	return h
+}
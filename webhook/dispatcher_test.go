@@ -0,0 +1,244 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flip-id/wappin"
+	v2 "github.com/flip-id/wappin/v2"
+	"github.com/flip-id/wappin/webhook"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var errHandler = errors.New("handler failed")
+
+func newWebhook() *v2.Webhook {
+	return &v2.Webhook{
+		Contacts: []v2.ContactWebhook{{Profile: v2.ProfileWebhook{Name: "Budi"}, WaId: "6288889999"}},
+		Statuses: []v2.StatusWebhook{
+			{Id: "status-id", RecipientId: "6281111111", Status: v2.MessageStatusDelivered, Timestamp: "1691034336"},
+		},
+		Messages: []v2.MessageWebhook{
+			{Id: "text-id", From: "6288889999", Timestamp: "1691034336", Type: v2.MessageTypeText, Text: &v2.TextWebhook{Body: "halo"}},
+			{Id: "image-id", From: "6288889999", Timestamp: "1691034336", Type: v2.MessageTypeImage, Image: &v2.MediaWebhook{Id: "media-id", Caption: "bukti"}},
+			{Id: "button-id", From: "6288889999", Timestamp: "1691034336", Type: v2.MessageTypeButton, Button: &v2.ButtonWebhook{Payload: "refund-yes", Text: "Ya"}},
+			{
+				Id: "list-id", From: "6288889999", Timestamp: "1691034336", Type: v2.MessageTypeInteractive,
+				Interactive: &v2.InteractiveWebhook{Type: v2.InteractiveTypeListReply, ListReply: &v2.InteractiveReplyWebhook{Id: "bank-bca", Title: "BCA"}},
+			},
+			{Id: "location-id", From: "6288889999", Timestamp: "1691034336", Type: v2.MessageTypeLocation, Location: &v2.LocationWebhook{}},
+		},
+	}
+}
+
+func TestDispatchWebhook(t *testing.T) {
+	var got []*webhook.Event
+	record := func(ctx context.Context, e *webhook.Event) error {
+		got = append(got, e)
+		return nil
+	}
+
+	d := webhook.NewDispatcher().
+		OnStatus(record).
+		OnText(record).
+		OnImage(record).
+		OnButtonReply(record).
+		OnListReply(record).
+		OnUnknown(record)
+
+	err := d.DispatchWebhook(context.Background(), newWebhook())
+
+	assert.Nil(t, err)
+	assert.Len(t, got, 6)
+
+	assert.Equal(t, webhook.EventTypeStatus, got[0].Type)
+	assert.Equal(t, wappin.MessageStatusDelivered, got[0].Status)
+	assert.Equal(t, "6281111111", got[0].Phone)
+	assert.True(t, time.Unix(1691034336, 0).Equal(got[0].Time))
+
+	assert.Equal(t, webhook.EventTypeText, got[1].Type)
+	assert.Equal(t, "halo", got[1].Text)
+	assert.Equal(t, "Budi", got[1].Name)
+
+	assert.Equal(t, webhook.EventTypeImage, got[2].Type)
+	assert.Equal(t, "media-id", got[2].Image.Id)
+	assert.Equal(t, "bukti", got[2].Text)
+
+	assert.Equal(t, webhook.EventTypeButtonReply, got[3].Type)
+	assert.Equal(t, &webhook.Reply{Id: "refund-yes", Title: "Ya"}, got[3].Reply)
+
+	assert.Equal(t, webhook.EventTypeListReply, got[4].Type)
+	assert.Equal(t, &webhook.Reply{Id: "bank-bca", Title: "BCA"}, got[4].Reply)
+
+	assert.Equal(t, webhook.EventTypeUnknown, got[5].Type)
+	assert.Equal(t, v2.MessageTypeLocation, got[5].Message.Type)
+}
+
+func TestDispatchCallback(t *testing.T) {
+	tt := []struct {
+		name       string
+		cb         *wappin.Callback
+		expectType webhook.EventType
+		expectErr  error
+	}{
+		{
+			name: "status callback",
+			cb: &wappin.Callback{
+				CallbackData: wappin.CallbackData{MessageID: "message-id", StatusMessages: "read"},
+				Status:       wappin.MessageStatusRead,
+				Type:         wappin.CallbackTypeMessageStatus,
+			},
+			expectType: webhook.EventTypeStatus,
+		},
+		{
+			name: "incoming message callback",
+			cb: &wappin.Callback{
+				CallbackData: wappin.CallbackData{MessageID: "message-id", MessageContent: "halo"},
+				Type:         wappin.CallbackTypeIncomingMessage,
+			},
+			expectType: webhook.EventTypeText,
+		},
+		{
+			name:       "unknown callback falls back to unknown handler",
+			cb:         &wappin.Callback{Type: wappin.CallbackTypeUnknown},
+			expectType: webhook.EventTypeUnknown,
+		},
+		{
+			name:      "nil callback",
+			expectErr: wappin.ErrNilArguments,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *webhook.Event
+			d := webhook.NewDispatcher().
+				OnStatus(func(ctx context.Context, e *webhook.Event) error {
+					got = e
+					return nil
+				}).
+				OnUnknown(func(ctx context.Context, e *webhook.Event) error {
+					got = e
+					return nil
+				})
+
+			err := d.DispatchCallback(context.Background(), tc.cb)
+
+			assert.Equal(t, tc.expectErr, err)
+			if tc.expectErr != nil {
+				return
+			}
+
+			assert.Equal(t, tc.expectType, got.Type)
+			assert.Equal(t, tc.cb, got.Callback)
+		})
+	}
+}
+
+func TestDispatcherMiddleware(t *testing.T) {
+	var calls []string
+	middleware := func(name string) webhook.Middleware {
+		return func(next webhook.Handler) webhook.Handler {
+			return func(ctx context.Context, e *webhook.Event) error {
+				calls = append(calls, name)
+				return next(ctx, e)
+			}
+		}
+	}
+
+	d := webhook.NewDispatcher().
+		Use(middleware("first"), middleware("second")).
+		OnText(func(ctx context.Context, e *webhook.Event) error {
+			calls = append(calls, "handler")
+			return nil
+		})
+
+	err := d.Dispatch(context.Background(), &webhook.Event{Type: webhook.EventTypeText})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first", "second", "handler"}, calls)
+}
+
+func TestDispatcherHandlerID(t *testing.T) {
+	var ids []string
+	record := func(next webhook.Handler) webhook.Handler {
+		return func(ctx context.Context, e *webhook.Event) error {
+			ids = append(ids, webhook.HandlerID(ctx))
+			return next(ctx, e)
+		}
+	}
+	noop := func(ctx context.Context, e *webhook.Event) error {
+		return nil
+	}
+
+	d := webhook.NewDispatcher().
+		Use(record).
+		OnStatus(noop).
+		OnStatus(noop).
+		OnUnknown(noop)
+
+	assert.Nil(t, d.Dispatch(context.Background(), &webhook.Event{Type: webhook.EventTypeStatus}))
+	assert.Nil(t, d.Dispatch(context.Background(), &webhook.Event{Type: webhook.EventTypeText}))
+	assert.Equal(t, []string{"status:0", "status:1", "unknown:0"}, ids)
+	assert.Empty(t, webhook.HandlerID(context.Background()))
+}
+
+func TestDispatcherErrorAggregation(t *testing.T) {
+	var calls int
+	d := webhook.NewDispatcher().
+		OnStatus(func(ctx context.Context, e *webhook.Event) error {
+			calls++
+			return errHandler
+		}).
+		OnText(func(ctx context.Context, e *webhook.Event) error {
+			calls++
+			return errHandler
+		}).
+		OnImage(func(ctx context.Context, e *webhook.Event) error {
+			calls++
+			return nil
+		})
+
+	err := d.DispatchWebhook(context.Background(), newWebhook())
+
+	var dispatchErr *webhook.DispatchError
+	assert.True(t, errors.As(err, &dispatchErr))
+	assert.True(t, errors.Is(err, errHandler))
+	assert.Len(t, dispatchErr.Errors, 2)
+	assert.Equal(t, 3, calls)
+}
+
+func TestDispatchWebhookInvalidTimestamp(t *testing.T) {
+	var calls int
+	d := webhook.NewDispatcher().
+		OnStatus(func(ctx context.Context, e *webhook.Event) error {
+			calls++
+			return nil
+		})
+
+	t.Run("Error without dispatching any event", func(t *testing.T) {
+		wh := newWebhook()
+		wh.Statuses = append(wh.Statuses, v2.StatusWebhook{Id: "invalid-id", Status: v2.MessageStatusRead, Timestamp: "yesterday"})
+
+		err := d.DispatchWebhook(context.Background(), wh)
+
+		assert.True(t, errors.Is(err, v2.ErrInvalidWebhook))
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("Reject with bad request", func(t *testing.T) {
+		body := `{"statuses":[{"id":"status-id","status":"delivered","timestamp":"1691034336"},{"id":"invalid-id","status":"read","timestamp":"yesterday"}]}`
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+
+		v2.NewWebhookHandler(d.DispatchWebhook).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, 0, calls)
+	})
+}
@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/flip-id/wappin"
+	v2 "github.com/flip-id/wappin/v2"
+)
+
+// EventType is the type of the event dispatched by the Dispatcher.
+type EventType string
+
+// List of event types dispatched by the Dispatcher.
+const (
+	EventTypeUnknown     EventType = "unknown"
+	EventTypeStatus      EventType = "status"
+	EventTypeText        EventType = "text"
+	EventTypeImage       EventType = "image"
+	EventTypeButtonReply EventType = "button_reply"
+	EventTypeListReply   EventType = "list_reply"
+)
+
+// Event is the normalized event of the v1 callback and the v2 webhook.
+// Phone is the sender of the inbound message or the recipient of the sent message,
+// Name is only filled for the v2 inbound message.
+// Status is only filled for EventTypeStatus, Text is the body of the text message or the caption of the image,
+// Image is only filled for EventTypeImage and Reply is only filled for EventTypeButtonReply and EventTypeListReply.
+// Callback, Message and StatusWebhook are the original payloads, only one of them is filled.
+type Event struct {
+	Type      EventType
+	MessageID string
+	Phone     string
+	Name      string
+	Time      time.Time
+	Status    wappin.MessageStatus
+	Text      string
+	Image     *v2.MediaWebhook
+	Reply     *Reply
+
+	Callback      *wappin.Callback
+	Message       *v2.MessageWebhook
+	StatusWebhook *v2.StatusWebhook
+}
+
+// Reply is the button or the list row chosen by the user.
+// For the quick reply button of the template, Id is the payload of the button.
+type Reply struct {
+	Id          string
+	Title       string
+	Description string
+}
+
+// NewCallbackEvent converts the v1 callback to Event.
+func NewCallbackEvent(cb *wappin.Callback) *Event {
+	e := &Event{
+		Type:      EventTypeUnknown,
+		MessageID: cb.MessageID,
+		Phone:     cb.SenderNumber,
+		Time:      cb.Time,
+		Callback:  cb,
+	}
+
+	switch cb.Type {
+	case wappin.CallbackTypeMessageStatus:
+		e.Type = EventTypeStatus
+		e.Status = cb.Status
+	case wappin.CallbackTypeIncomingMessage:
+		e.Type = EventTypeText
+		e.Text = cb.MessageContent
+	}
+
+	return e
+}
+
+// NewWebhookEvents converts the statuses and the messages of the v2 webhook to events.
+// The event with an invalid timestamp is returned with the zero Time along with the error.
+func NewWebhookEvents(wh *v2.Webhook) (events []*Event, err error) {
+	errs := new(DispatchError)
+	for i := range wh.Statuses {
+		e, errEvent := newStatusEvent(&wh.Statuses[i])
+		errs.add(e, errEvent)
+		events = append(events, e)
+	}
+
+	names := make(map[string]string, len(wh.Contacts))
+	for _, contact := range wh.Contacts {
+		names[contact.WaId] = contact.Profile.Name
+	}
+
+	for i := range wh.Messages {
+		e, errEvent := newMessageEvent(&wh.Messages[i])
+		e.Name = names[e.Phone]
+		errs.add(e, errEvent)
+		events = append(events, e)
+	}
+
+	err = errs.err()
+	return
+}
+
+func newStatusEvent(s *v2.StatusWebhook) (e *Event, err error) {
+	e = &Event{
+		Type:          EventTypeStatus,
+		MessageID:     s.Id,
+		Phone:         s.RecipientId,
+		Status:        wappin.ParseMessageStatus(s.Status),
+		StatusWebhook: s,
+	}
+
+	e.Time, err = s.Time()
+	return
+}
+
+func newMessageEvent(m *v2.MessageWebhook) (e *Event, err error) {
+	e = &Event{
+		Type:      EventTypeUnknown,
+		MessageID: m.Id,
+		Phone:     m.From,
+		Message:   m,
+	}
+
+	switch {
+	case m.Type == v2.MessageTypeText && m.Text != nil:
+		e.Type = EventTypeText
+		e.Text = m.Text.Body
+	case m.Type == v2.MessageTypeImage && m.Image != nil:
+		e.Type = EventTypeImage
+		e.Text = m.Image.Caption
+		e.Image = m.Image
+	case m.Type == v2.MessageTypeButton && m.Button != nil:
+		e.Type = EventTypeButtonReply
+		e.Reply = &Reply{
+			Id:    m.Button.Payload,
+			Title: m.Button.Text,
+		}
+	case m.Type == v2.MessageTypeInteractive && m.Interactive != nil:
+		e.setInteractiveReply(m.Interactive)
+	}
+
+	e.Time, err = m.Time()
+	return
+}
+
+func (e *Event) setInteractiveReply(i *v2.InteractiveWebhook) {
+	var reply *v2.InteractiveReplyWebhook
+	switch {
+	case i.Type == v2.InteractiveTypeButtonReply && i.ButtonReply != nil:
+		e.Type = EventTypeButtonReply
+		reply = i.ButtonReply
+	case i.Type == v2.InteractiveTypeListReply && i.ListReply != nil:
+		e.Type = EventTypeListReply
+		reply = i.ListReply
+	default:
+		return
+	}
+
+	e.Reply = &Reply{
+		Id:          reply.Id,
+		Title:       reply.Title,
+		Description: reply.Description,
+	}
+}